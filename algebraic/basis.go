@@ -0,0 +1,265 @@
+package algebraic
+
+import (
+	"errors"
+	"math"
+)
+
+// Various errors a basis function can return.
+var (
+	ErrLinearlyDependent = errors.New("vectors are linearly dependent")
+)
+
+// basisEps is the relative tolerance used to decide if a vector is a linear
+// combination of others, i.e. if its component orthogonal to them vanishes.
+const basisEps = 1e-10
+
+// GramSchmidt creates and returns an orthonormal set of vectors q₁, ..., qₖ
+// spanning the same space as the given vectors v₁, ..., vₖ, such that each qᵢ
+// spans the same space as v₁, ..., vᵢ. The modified Gram-Schmidt process is
+// used, where each new qᵢ is immediately projected out of all the remaining
+// vectors, rather than projecting each vᵢ onto all previous q's at once. The
+// two are equivalent in exact arithmetic, but the modified process is far less
+// prone to loss of orthogonality in floating point. If the vectors are linearly
+// dependent, an error is returned.
+func GramSchmidt(vs ...Vector) ([]Vector, error) {
+	qs, idx, err := orthonormalize(vs)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(idx) != len(vs) {
+		return nil, ErrLinearlyDependent
+	}
+
+	return qs, nil
+}
+
+// LinearlyIndependent reports whether the given vectors are linearly
+// independent, i.e. if none of them is a linear combination of the others. An
+// empty set of vectors is linearly independent.
+func LinearlyIndependent(vs ...Vector) (bool, error) {
+	_, idx, err := orthonormalize(vs)
+	if err != nil {
+		return false, err
+	}
+
+	return len(idx) == len(vs), nil
+}
+
+// Basis returns a basis for the span of the given vectors, as the maximal
+// subset of linearly independent vectors picked in order, i.e. a vector is
+// left out if it is a linear combination of the vectors before it. The number
+// of vectors returned is the dimension of the span.
+func Basis(vs ...Vector) ([]Vector, error) {
+	_, idx, err := orthonormalize(vs)
+	if err != nil {
+		return nil, err
+	}
+
+	bs := make([]Vector, len(idx))
+	for k, i := range idx {
+		bs[k] = NewVector(vs[i].Dimension(), vs[i]...)
+	}
+
+	return bs, nil
+}
+
+// InSpan reports whether vector w is in the span of the given vectors, i.e. if
+// it is a linear combination of them. This is the case if the component of w
+// orthogonal to an orthonormal basis of the span vanishes.
+func InSpan(w Vector, vs ...Vector) (bool, error) {
+	qs, _, err := orthonormalize(vs)
+	if err != nil {
+		return false, err
+	}
+
+	if len(vs) > 0 && len(w) != len(vs[0]) {
+		return false, ErrInvalidDims
+	}
+
+	r := NewVector(w.Dimension(), w...)
+	for _, q := range qs {
+		d, _ := q.Dot(r)
+		r.addScaled(q, -d)
+	}
+
+	return r.Magnitude() <= basisEps*math.Max(1, w.Magnitude()), nil
+}
+
+// Rank returns the rank of the matrix, i.e. the dimension of its column space,
+// which equals the dimension of its row space.
+func (m Matrix) Rank() (int, error) {
+	_, pivots, err := m.rref()
+	if err != nil {
+		return 0, err
+	}
+
+	return len(pivots), nil
+}
+
+// ColumnSpace returns a basis for the column space of the matrix, i.e. the
+// span of its columns. The basis consists of the pivot columns of the matrix,
+// which are the columns holding a leading one in its reduced row echelon form.
+func (m Matrix) ColumnSpace() ([]Vector, error) {
+	_, pivots, err := m.rref()
+	if err != nil {
+		return nil, err
+	}
+
+	cs := make([]Vector, len(pivots))
+	for k, j := range pivots {
+		cs[k] = NewZeroVector(uint(len(m)))
+		for i := range m {
+			cs[k][i] = m[i][j]
+		}
+	}
+
+	return cs, nil
+}
+
+// NullSpace returns a basis for the null space of the matrix, i.e. the set of
+// vectors x such that m·x = 0. In the reduced row echelon form of m, each
+// column without a pivot corresponds to a free variable. Setting one free
+// variable to 1 and the others to 0 determines the pivot variables, and gives
+// one basis vector per free variable. The dimension of the null space is the
+// number of columns minus the rank.
+// |1  2  3|          |1  0  -1|          | 1|
+// |4  5  6|    =>    |0  1   2|    =>    |-2|
+// |7  8  9|          |0  0   0|          | 1|
+func (m Matrix) NullSpace() ([]Vector, error) {
+	r, pivots, err := m.rref()
+	if err != nil {
+		return nil, err
+	}
+
+	_, cols := m.Dims()
+	isPivot := make([]bool, cols)
+	for _, j := range pivots {
+		isPivot[j] = true
+	}
+
+	var ns []Vector
+	for f := range int(cols) {
+		if isPivot[f] {
+			continue
+		}
+
+		x := NewUnitVector(cols, uint(f))
+		for i, j := range pivots {
+			x[j] = -r[i][f]
+		}
+
+		ns = append(ns, x)
+	}
+
+	return ns, nil
+}
+
+// rref creates and returns the reduced row echelon form of the matrix, along
+// with the indices of its pivot columns, by Gauss-Jordan elimination with
+// partial pivoting. Coordinates below a tolerance relative to the largest
+// absolute coordinate of the matrix are considered zero.
+func (m Matrix) rref() (Matrix, []int, error) {
+	rows, cols, err := m.shape()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := m.scaled(1)
+
+	var largest float64
+	for _, r := range a {
+		for _, c := range r {
+			largest = math.Max(largest, math.Abs(c))
+		}
+	}
+
+	tol := basisEps * math.Max(1, largest)
+
+	var pivots []int
+	i := 0
+	for j := 0; j < cols && i < rows; j++ {
+		p := i
+		for k := i + 1; k < rows; k++ {
+			if math.Abs(a[k][j]) > math.Abs(a[p][j]) {
+				p = k
+			}
+		}
+
+		if math.Abs(a[p][j]) <= tol {
+			for k := i; k < rows; k++ {
+				a[k][j] = 0
+			}
+
+			continue
+		}
+
+		a[i], a[p] = a[p], a[i]
+		a[i].Scale(1 / a[i][j])
+
+		for k := range rows {
+			if k != i {
+				a[k].addScaled(a[i], -a[k][j])
+			}
+		}
+
+		pivots = append(pivots, j)
+		i++
+	}
+
+	return a, pivots, nil
+}
+
+// orthonormalize runs the modified Gram-Schmidt process on the given vectors,
+// skipping vectors that are linear combinations of the previous ones. It
+// returns the orthonormal vectors along with the indices of the vectors they
+// originate from.
+func orthonormalize(vs []Vector) ([]Vector, []int, error) {
+	if len(vs) == 0 {
+		return nil, nil, nil
+	}
+
+	dim := len(vs[0])
+	ws := make([]Vector, len(vs))
+	for i, v := range vs {
+		if len(v) != dim {
+			return nil, nil, ErrInvalidDims
+		}
+
+		ws[i] = NewVector(v.Dimension(), v...)
+	}
+
+	var (
+		qs  []Vector
+		idx []int
+	)
+
+	for i, w := range ws {
+		if w.Magnitude() <= basisEps*math.Max(1, vs[i].Magnitude()) {
+			continue
+		}
+
+		w.Normalize()
+		qs = append(qs, w)
+		idx = append(idx, i)
+
+		// project the new direction out of all remaining vectors
+		for _, u := range ws[i+1:] {
+			d, _ := w.Dot(u)
+			u.addScaled(w, -d)
+		}
+	}
+
+	return qs, idx, nil
+}
+
+// addScaled adds vector w multiplied by a given scalar value to vector v, in
+// place.
+func (v Vector) addScaled(w Vector, scalar float64) {
+	for k := range v {
+		if k < len(w) {
+			v[k] += scalar * w[k]
+		}
+	}
+}
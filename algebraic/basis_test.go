@@ -0,0 +1,304 @@
+package algebraic_test
+
+import (
+	"testing"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGramSchmidt(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vs   []algebraic.Vector
+		want []algebraic.Vector
+		err  error
+	}{
+		"should return orthonormal vectors": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(3, 3, 0, 0),
+				algebraic.NewVector(3, 1, 2, 0),
+				algebraic.NewVector(3, 1, 1, 5),
+			},
+			want: []algebraic.Vector{
+				algebraic.NewVector(3, 1, 0, 0),
+				algebraic.NewVector(3, 0, 1, 0),
+				algebraic.NewVector(3, 0, 0, 1),
+			},
+		},
+		"should return orthonormal vectors in a plane": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(3, 1, 1, 0),
+				algebraic.NewVector(3, 1, 0, 0),
+			},
+			want: []algebraic.Vector{
+				algebraic.NewVector(3, 1/1.4142135623730951, 1/1.4142135623730951, 0),
+				algebraic.NewVector(3, 1/1.4142135623730951, -1/1.4142135623730951, 0),
+			},
+		},
+		"should return nothing for no vectors": {},
+		"should return error for dependent vectors": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(2, 1, 2),
+				algebraic.NewVector(2, 2, 4),
+			},
+			err: algebraic.ErrLinearlyDependent,
+		},
+		"should return error for zero vector": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(2, 0, 0),
+			},
+			err: algebraic.ErrLinearlyDependent,
+		},
+		"should return error for unequal dimensions": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(2, 1, 0),
+				algebraic.NewVector(3, 0, 1, 0),
+			},
+			err: algebraic.ErrInvalidDims,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.GramSchmidt(test.vs...)
+			assert.Equal(test.err, err)
+			assert.Len(got, len(test.want))
+			for i := range test.want {
+				assert.InDeltaSlice(test.want[i], got[i], 1e-12)
+			}
+		})
+	}
+}
+
+func TestGramSchmidtOrthogonality(t *testing.T) {
+	assert := assert.New(t)
+
+	// the columns of the Hilbert matrix are notoriously close to dependent
+	h := algebraic.NewHilbertMatrix(6).Transpose()
+	qs, err := algebraic.GramSchmidt(h...)
+	assert.NoError(err)
+
+	for i := range qs {
+		for j := range qs {
+			d, _ := qs[i].Dot(qs[j])
+			if i == j {
+				assert.InDelta(1, d, 1e-9)
+			} else {
+				assert.InDelta(0, d, 1e-6)
+			}
+		}
+	}
+}
+
+func TestLinearlyIndependent(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vs   []algebraic.Vector
+		want bool
+	}{
+		"should return true for unit vectors": {
+			vs: []algebraic.Vector{
+				algebraic.NewUnitVector(3, 0),
+				algebraic.NewUnitVector(3, 1),
+			},
+			want: true,
+		},
+		"should return false for a linear combination": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(3, 1, 2, 3),
+				algebraic.NewVector(3, 4, 5, 6),
+				algebraic.NewVector(3, 7, 8, 9),
+			},
+			want: false,
+		},
+		"should return false for more vectors than dimensions": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(2, 1, 2),
+				algebraic.NewVector(2, 3, 1),
+				algebraic.NewVector(2, 5, 7),
+			},
+			want: false,
+		},
+		"should return true for no vectors": {
+			want: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.LinearlyIndependent(test.vs...)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+}
+
+func TestBasis(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vs   []algebraic.Vector
+		want []algebraic.Vector
+	}{
+		"should skip dependent vectors": {
+			vs: []algebraic.Vector{
+				algebraic.NewVector(3, 1, 2, 3),
+				algebraic.NewVector(3, 2, 4, 6),
+				algebraic.NewVector(3, 4, 5, 6),
+				algebraic.NewVector(3, 7, 8, 9),
+				algebraic.NewVector(3, 0, 0, 1),
+			},
+			want: []algebraic.Vector{
+				algebraic.NewVector(3, 1, 2, 3),
+				algebraic.NewVector(3, 4, 5, 6),
+				algebraic.NewVector(3, 0, 0, 1),
+			},
+		},
+		"should skip zero vectors": {
+			vs: []algebraic.Vector{
+				algebraic.NewZeroVector(2),
+				algebraic.NewVector(2, 1, 1),
+			},
+			want: []algebraic.Vector{
+				algebraic.NewVector(2, 1, 1),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.Basis(test.vs...)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+}
+
+func TestInSpan(t *testing.T) {
+	assert := assert.New(t)
+	vs := []algebraic.Vector{
+		algebraic.NewVector(3, 1, 0, 1),
+		algebraic.NewVector(3, 0, 1, 1),
+	}
+
+	got, err := algebraic.InSpan(algebraic.NewVector(3, 2, 3, 5), vs...)
+	assert.NoError(err)
+	assert.True(got)
+
+	got, err = algebraic.InSpan(algebraic.NewVector(3, 0, 0, 1), vs...)
+	assert.NoError(err)
+	assert.False(got)
+
+	_, err = algebraic.InSpan(algebraic.NewVector(2, 1, 1), vs...)
+	assert.Equal(algebraic.ErrInvalidDims, err)
+}
+
+func TestRank(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m    algebraic.Matrix
+		want int
+	}{
+		"should return full rank": {
+			m:    algebraic.NewIdentityMatrix(3, 3),
+			want: 3,
+		},
+		"should return rank of singular matrix": {
+			m: algebraic.NewMatrix(3, 3,
+				1, 2, 3,
+				4, 5, 6,
+				7, 8, 9,
+			),
+			want: 2,
+		},
+		"should return rank of wide matrix": {
+			m: algebraic.NewMatrix(2, 4,
+				1, 2, 3, 4,
+				2, 4, 6, 8,
+			),
+			want: 1,
+		},
+		"should return rank of zero matrix": {
+			m:    algebraic.NewMatrix(2, 3),
+			want: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.Rank()
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+}
+
+func TestColumnSpace(t *testing.T) {
+	assert := assert.New(t)
+	m := algebraic.NewMatrix(3, 4,
+		1, 2, 0, 1,
+		2, 4, 1, 1,
+		3, 6, 1, 2,
+	)
+
+	got, err := m.ColumnSpace()
+	assert.NoError(err)
+	assert.Equal([]algebraic.Vector{
+		algebraic.NewVector(3, 1, 2, 3),
+		algebraic.NewVector(3, 0, 1, 1),
+	}, got)
+
+	_, err = algebraic.Matrix{{1, 2}, {3}}.ColumnSpace()
+	assert.Equal(algebraic.ErrUnequalRows, err)
+}
+
+func TestNullSpace(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m    algebraic.Matrix
+		want []algebraic.Vector
+	}{
+		"should return null space of singular matrix": {
+			m: algebraic.NewMatrix(3, 3,
+				1, 2, 3,
+				4, 5, 6,
+				7, 8, 9,
+			),
+			want: []algebraic.Vector{
+				algebraic.NewVector(3, 1, -2, 1),
+			},
+		},
+		"should return null space of wide matrix": {
+			m: algebraic.NewMatrix(3, 4,
+				1, 2, 0, 1,
+				2, 4, 1, 1,
+				3, 6, 1, 2,
+			),
+			want: []algebraic.Vector{
+				algebraic.NewVector(4, -2, 1, 0, 0),
+				algebraic.NewVector(4, -1, 0, 1, 1),
+			},
+		},
+		"should return empty null space of non-singular matrix": {
+			m: algebraic.NewMatrix(2, 2,
+				2, 1,
+				1, 3,
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.NullSpace()
+			assert.NoError(err)
+			assert.Len(got, len(test.want))
+			for i := range test.want {
+				assert.InDeltaSlice(test.want[i], got[i], 1e-12)
+
+				// every basis vector must be mapped to zero
+				z, _ := test.m.MulVec(got[i])
+				assert.InDeltaSlice(algebraic.NewZeroVector(z.Dimension()), z, 1e-12)
+			}
+		})
+	}
+}
@@ -0,0 +1,424 @@
+package algebraic
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Layout defines how a matrix or vector is laid out when printed.
+type Layout int
+
+const (
+	// LayoutPlain lays out coordinates in aligned columns, with each row of a
+	// matrix enclosed in vertical bars.
+	LayoutPlain Layout = iota
+	// LayoutLaTeX lays out coordinates as a LaTeX bmatrix environment.
+	LayoutLaTeX
+	// LayoutMarkdown lays out coordinates as a Markdown table with the column
+	// indices as header.
+	LayoutMarkdown
+)
+
+// ellipsis marks the coordinates left out of an elided matrix or vector.
+const ellipsis = "..."
+
+// PrintOptions defines how the coordinates of a matrix or vector are printed.
+// Verb and Precision follow the conventions of strconv.FormatFloat, so a
+// negative precision prints the smallest number of digits necessary. A zero
+// Verb defaults to 'g', and if Precision is zero as well, it defaults to -1,
+// so options left at their zero values print coordinates the same way as
+// DefaultPrintOptions. Width is the minimum width of each column, and Plus
+// forces a sign on non-negative coordinates. When the total number of
+// coordinates exceeds Threshold, only the first and last EdgeItems rows and
+// columns are printed, while the rest are replaced by an ellipsis. A Threshold
+// of 0 disables elision.
+type PrintOptions struct {
+	Layout    Layout
+	Verb      byte
+	Precision int
+	Width     int
+	Plus      bool
+	EdgeItems int
+	Threshold int
+}
+
+// DefaultPrintOptions are the options used when formatting a matrix or vector
+// with the %v verb.
+var DefaultPrintOptions = PrintOptions{
+	Layout:    LayoutPlain,
+	Verb:      'g',
+	Precision: -1,
+	EdgeItems: 3,
+	Threshold: 1000,
+}
+
+// Fprint writes the vector to w laid out according to the given options, and
+// terminated by a newline.
+func (v Vector) Fprint(w io.Writer, opts PrintOptions) error {
+	_, err := io.WriteString(w, v.layout(opts)+"\n")
+	return err
+}
+
+// Fprint writes the matrix to w laid out according to the given options, and
+// terminated by a newline.
+func (m Matrix) Fprint(w io.Writer, opts PrintOptions) error {
+	_, err := io.WriteString(w, m.layout(opts)+"\n")
+	return err
+}
+
+// String returns the vector laid out with the default print options, e.g.
+// [1 2 3].
+func (v Vector) String() string {
+	return v.layout(DefaultPrintOptions)
+}
+
+// String returns the matrix laid out with the default print options, with
+// each row on a separate line.
+func (m Matrix) String() string {
+	return m.layout(DefaultPrintOptions)
+}
+
+// GoString returns a Go-syntax representation of the vector.
+func (v Vector) GoString() string {
+	return "algebraic.Vector{" + v.goCoords() + "}"
+}
+
+// GoString returns a Go-syntax representation of the matrix.
+func (m Matrix) GoString() string {
+	rs := make([]string, len(m))
+	for i, r := range m {
+		rs[i] = r.GoString()
+	}
+
+	return "algebraic.Matrix{" + strings.Join(rs, ", ") + "}"
+}
+
+// Format implements fmt.Formatter for the vector. The verbs %v and %s use the
+// shortest representation of each coordinate, while %e, %f and %g use the
+// given precision. The width and the + flag apply to each coordinate, and %#v
+// prints the Go-syntax representation.
+func (v Vector) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('#') {
+		io.WriteString(s, v.GoString())
+		return
+	}
+
+	opts, ok := stateOptions(s, verb)
+	if !ok {
+		fmt.Fprintf(s, "%%!%c(algebraic.Vector=%s)", verb, v.String())
+		return
+	}
+
+	io.WriteString(s, v.layout(opts))
+}
+
+// Format implements fmt.Formatter for the matrix, following the same rules as
+// Vector.Format.
+func (m Matrix) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('#') {
+		io.WriteString(s, m.GoString())
+		return
+	}
+
+	opts, ok := stateOptions(s, verb)
+	if !ok {
+		fmt.Fprintf(s, "%%!%c(algebraic.Matrix=%s)", verb, m.String())
+		return
+	}
+
+	io.WriteString(s, m.layout(opts))
+}
+
+// stateOptions derives the print options from a given fmt state and verb. If
+// the verb is not supported, false is returned.
+func stateOptions(s fmt.State, verb rune) (PrintOptions, bool) {
+	opts := DefaultPrintOptions
+
+	switch verb {
+	case 'v', 's':
+		opts.Verb = 'g'
+	case 'e', 'E', 'f', 'g', 'G':
+		opts.Verb = byte(verb)
+	case 'F':
+		opts.Verb = 'f'
+	default:
+		return opts, false
+	}
+
+	if p, ok := s.Precision(); ok {
+		opts.Precision = p
+	}
+
+	if w, ok := s.Width(); ok {
+		opts.Width = w
+	}
+
+	opts.Plus = s.Flag('+')
+
+	return opts, true
+}
+
+// formatCoord formats a single coordinate according to the options.
+func (o PrintOptions) formatCoord(c float64) string {
+	verb, prec := o.Verb, o.Precision
+	if verb == 0 {
+		verb = 'g'
+		if prec == 0 {
+			prec = -1
+		}
+	}
+
+	s := strconv.FormatFloat(c, verb, prec, 64)
+	if o.Plus && s[0] != '-' && s[0] != '+' {
+		s = "+" + s
+	}
+
+	return s
+}
+
+// indices returns the indices to print along a dimension of length n. If the
+// dimension is elided, the index -1 marks the position of the ellipsis.
+func (o PrintOptions) indices(n int, elide bool) []int {
+	var is []int
+
+	if !elide || n <= 2*o.EdgeItems {
+		for i := range n {
+			is = append(is, i)
+		}
+
+		return is
+	}
+
+	for i := range o.EdgeItems {
+		is = append(is, i)
+	}
+
+	is = append(is, -1)
+
+	for i := n - o.EdgeItems; i < n; i++ {
+		is = append(is, i)
+	}
+
+	return is
+}
+
+// elide reports whether a given number of coordinates exceeds the threshold.
+func (o PrintOptions) elide(n int) bool {
+	return o.Threshold > 0 && n > o.Threshold
+}
+
+// cells returns the formatted coordinates of the vector, with elided
+// coordinates replaced by an ellipsis.
+func (v Vector) cells(o PrintOptions) []string {
+	var cs []string
+
+	for _, i := range o.indices(len(v), o.elide(len(v))) {
+		if i < 0 {
+			cs = append(cs, ellipsis)
+		} else {
+			cs = append(cs, o.formatCoord(v[i]))
+		}
+	}
+
+	return cs
+}
+
+// cells returns the formatted coordinates of the matrix row by row, along with
+// the row and column indices they originate from. Elided rows and columns are
+// replaced by an ellipsis, and marked by the index -1.
+func (m Matrix) cells(o PrintOptions) ([][]string, []int, []int) {
+	var (
+		n    int
+		cols int
+		grid [][]string
+	)
+
+	for _, r := range m {
+		n += len(r)
+		cols = max(cols, len(r))
+	}
+
+	elide := o.elide(n)
+	ris := o.indices(len(m), elide)
+	cis := o.indices(cols, elide)
+
+	for _, i := range ris {
+		cs := make([]string, len(cis))
+		for k, j := range cis {
+			switch {
+			case i < 0 || j < 0:
+				cs[k] = ellipsis
+			case j < len(m[i]):
+				cs[k] = o.formatCoord(m[i][j])
+			}
+		}
+
+		grid = append(grid, cs)
+	}
+
+	return grid, ris, cis
+}
+
+// layout returns the vector laid out according to the options.
+func (v Vector) layout(o PrintOptions) string {
+	cs := v.cells(o)
+
+	switch o.Layout {
+	case LayoutLaTeX:
+		return "\\begin{bmatrix}\n" + strings.Join(latexCells(cs, "\\vdots"), " \\\\\n") +
+			"\n\\end{bmatrix}"
+	case LayoutMarkdown:
+		return markdownTable([][]string{cs}, o.indices(len(v), o.elide(len(v))), o.Width)
+	default:
+		return "[" + strings.Join(pad(cs, o.Width), " ") + "]"
+	}
+}
+
+// layout returns the matrix laid out according to the options.
+func (m Matrix) layout(o PrintOptions) string {
+	grid, ris, cis := m.cells(o)
+
+	switch o.Layout {
+	case LayoutLaTeX:
+		rs := make([]string, len(grid))
+		for i, cs := range grid {
+			if ris[i] < 0 {
+				for k, j := range cis {
+					if j < 0 {
+						cs[k] = "\\ddots"
+					} else {
+						cs[k] = "\\vdots"
+					}
+				}
+			} else {
+				cs = latexCells(cs, "\\cdots")
+			}
+
+			rs[i] = strings.Join(cs, " & ")
+		}
+
+		return "\\begin{bmatrix}\n" + strings.Join(rs, " \\\\\n") + "\n\\end{bmatrix}"
+	case LayoutMarkdown:
+		return markdownTable(grid, cis, o.Width)
+	default:
+		if len(grid) == 0 {
+			return "[]"
+		}
+
+		ws := colWidths(grid, o.Width)
+		rs := make([]string, len(grid))
+		for i, cs := range grid {
+			ps := make([]string, len(cs))
+			for k, c := range cs {
+				ps[k] = padLeft(c, ws[k])
+			}
+
+			rs[i] = "|" + strings.Join(ps, "  ") + "|"
+		}
+
+		return strings.Join(rs, "\n")
+	}
+}
+
+// markdownTable returns the given rows of cells as a Markdown table, with the
+// column indices as header and right-aligned columns.
+func markdownTable(grid [][]string, cis []int, width int) string {
+	var sb strings.Builder
+
+	hs := make([]string, len(cis))
+	for k, j := range cis {
+		if j < 0 {
+			hs[k] = ellipsis
+		} else {
+			hs[k] = strconv.Itoa(j)
+		}
+	}
+
+	ws := colWidths(append([][]string{hs}, grid...), max(width, 3))
+
+	row := func(cs []string) {
+		sb.WriteString("|")
+		for k, c := range cs {
+			sb.WriteString(" " + padLeft(c, ws[k]) + " |")
+		}
+	}
+
+	row(hs)
+	sb.WriteString("\n|")
+	for _, w := range ws {
+		sb.WriteString(" " + strings.Repeat("-", w-1) + ": |")
+	}
+
+	for _, cs := range grid {
+		sb.WriteString("\n")
+		row(cs)
+	}
+
+	return sb.String()
+}
+
+// latexCells replaces any ellipsis in the given cells with a LaTeX dots
+// command.
+func latexCells(cs []string, dots string) []string {
+	ls := make([]string, len(cs))
+	for k, c := range cs {
+		if c == ellipsis {
+			ls[k] = dots
+		} else {
+			ls[k] = c
+		}
+	}
+
+	return ls
+}
+
+// colWidths returns the width of each column in the grid, which is at least
+// a given minimum width.
+func colWidths(grid [][]string, width int) []int {
+	var ws []int
+
+	for _, cs := range grid {
+		for k, c := range cs {
+			if k == len(ws) {
+				ws = append(ws, width)
+			}
+
+			ws[k] = max(ws[k], utf8.RuneCountInString(c))
+		}
+	}
+
+	return ws
+}
+
+// pad left pads each cell to a given width.
+func pad(cs []string, width int) []string {
+	ps := make([]string, len(cs))
+	for k, c := range cs {
+		ps[k] = padLeft(c, width)
+	}
+
+	return ps
+}
+
+// padLeft left pads s with spaces to a given width.
+func padLeft(s string, width int) string {
+	n := utf8.RuneCountInString(s)
+	if n >= width {
+		return s
+	}
+
+	return strings.Repeat(" ", width-n) + s
+}
+
+// goCoords returns the coordinates of the vector as a comma separated list.
+func (v Vector) goCoords() string {
+	cs := make([]string, len(v))
+	for k, c := range v {
+		cs[k] = strconv.FormatFloat(c, 'g', -1, 64)
+	}
+
+	return strings.Join(cs, ", ")
+}
@@ -0,0 +1,148 @@
+package algebraic_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+func TestFormat(t *testing.T) {
+	assert := assert.New(t)
+	m := algebraic.NewMatrix(2, 3,
+		1, -2.5, 3,
+		4, 5, 600,
+	)
+	v := algebraic.NewVector(3, 1, 2, 3)
+
+	tests := map[string]struct {
+		format string
+		arg    any
+		want   string
+	}{
+		"should format a vector with %v": {
+			format: "%v",
+			arg:    v,
+			want:   "[1 2 3]",
+		},
+		"should format a vector with width and precision": {
+			format: "%5.1f",
+			arg:    v,
+			want:   "[  1.0   2.0   3.0]",
+		},
+		"should format a vector with %#v": {
+			format: "%#v",
+			arg:    v,
+			want:   "algebraic.Vector{1, 2, 3}",
+		},
+		"should format a matrix with aligned columns": {
+			format: "%v",
+			arg:    m,
+			want:   "|1  -2.5    3|\n|4     5  600|",
+		},
+		"should format a matrix with precision": {
+			format: "%.2f",
+			arg:    m,
+			want:   "|1.00  -2.50    3.00|\n|4.00   5.00  600.00|",
+		},
+		"should format a matrix with signs": {
+			format: "%+v",
+			arg:    m,
+			want:   "|+1  -2.5    +3|\n|+4    +5  +600|",
+		},
+		"should format a matrix with %.3g": {
+			format: "%.3g",
+			arg:    algebraic.NewMatrix(1, 2, 1234.5, 0.000123),
+			want:   "|1.23e+03  0.000123|",
+		},
+		"should format a matrix with %#v": {
+			format: "%#v",
+			arg:    algebraic.NewMatrix(1, 2, 1, 2),
+			want:   "algebraic.Matrix{algebraic.Vector{1, 2}}",
+		},
+		"should report a bad verb": {
+			format: "%d",
+			arg:    v,
+			want:   "%!d(algebraic.Vector=[1 2 3])",
+		},
+		"should format an empty matrix": {
+			format: "%v",
+			arg:    algebraic.NewMatrix(0, 0),
+			want:   "[]",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := fmt.Sprintf(test.format, test.arg)
+			assert.Equal(test.want, got)
+		})
+	}
+}
+
+func TestFprint(t *testing.T) {
+	assert := assert.New(t)
+	elided := algebraic.DefaultPrintOptions
+	elided.Threshold = 10
+	elided.EdgeItems = 1
+
+	tests := map[string]struct {
+		m      algebraic.Matrix
+		layout algebraic.Layout
+		opts   *algebraic.PrintOptions
+		want   string
+	}{
+		"should print a matrix in plain layout": {
+			m:      algebraic.NewMatrix(2, 2, 1, 2, 3, 4),
+			layout: algebraic.LayoutPlain,
+			want:   "|1  2|\n|3  4|\n",
+		},
+		"should print a matrix in latex layout": {
+			m:      algebraic.NewMatrix(2, 2, 1, 2, 3, 4),
+			layout: algebraic.LayoutLaTeX,
+			want:   "\\begin{bmatrix}\n1 & 2 \\\\\n3 & 4\n\\end{bmatrix}\n",
+		},
+		"should print a matrix in markdown layout": {
+			m:      algebraic.NewMatrix(2, 2, 1, 2, 3, 4),
+			layout: algebraic.LayoutMarkdown,
+			want:   "|   0 |   1 |\n| --: | --: |\n|   1 |   2 |\n|   3 |   4 |\n",
+		},
+		"should print shortest coordinates with zero print options": {
+			m:      algebraic.NewMatrix(1, 2, 3.14159, 1234),
+			layout: algebraic.LayoutMarkdown,
+			opts:   &algebraic.PrintOptions{},
+			want:   "|       0 |    1 |\n| ------: | ---: |\n| 3.14159 | 1234 |\n",
+		},
+		"should print an elided matrix in plain layout": {
+			m:      algebraic.NewIdentityMatrix(4, 4),
+			layout: algebraic.LayoutPlain,
+			opts:   &elided,
+			want:   "|  1  ...    0|\n|...  ...  ...|\n|  0  ...    1|\n",
+		},
+		"should print an elided matrix in latex layout": {
+			m:      algebraic.NewIdentityMatrix(4, 4),
+			layout: algebraic.LayoutLaTeX,
+			opts:   &elided,
+			want: "\\begin{bmatrix}\n1 & \\cdots & 0 \\\\\n\\vdots & \\ddots & \\vdots \\\\\n" +
+				"0 & \\cdots & 1\n\\end{bmatrix}\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := algebraic.DefaultPrintOptions
+			if test.opts != nil {
+				opts = *test.opts
+			}
+			opts.Layout = test.layout
+
+			var sb strings.Builder
+			err := test.m.Fprint(&sb, opts)
+			assert.NoError(err)
+			assert.Equal(test.want, sb.String())
+		})
+	}
+}
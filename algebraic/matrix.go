@@ -3,6 +3,7 @@ package algebraic
 import (
 	"errors"
 	"fmt"
+	"math"
 )
 
 // Various errors a matrix function can return.
@@ -10,6 +11,11 @@ var (
 	ErrInvalidCols     = errors.New("matrix columns cannot be negative")
 	ErrColsOutOfBounds = errors.New("number of coordinates are less than colums")
 	ErrNotSquare       = errors.New("matrix is not square")
+	ErrUnequalRows     = errors.New("matrix rows are not of equal length")
+	ErrInvalidShape    = errors.New("matrix shapes are not compatible")
+	ErrNoMatrices      = errors.New("no matrices given")
+	ErrDiagMismatch    = errors.New("first coordinates of column and row are not equal")
+	ErrSingular        = errors.New("matrix is singular")
 )
 
 // Maxtrix defines a maxtrix structure with a slice of Vectors.
@@ -67,6 +73,435 @@ func NewIdentityMatrix(rows, cols uint) Matrix {
 	return m
 }
 
+// NewMatrixFromRows creates a new instance of a Matrix from a slice of row
+// vectors. Unlike NewMatrix, no zero-filling or truncation takes place, so if
+// the rows are not of equal length, an error is returned instead. The rows are
+// copied, so altering the matrix does not alter the given vectors.
+func NewMatrixFromRows(rows ...Vector) (Matrix, error) {
+	var m Matrix
+
+	for _, r := range rows {
+		if len(r) != len(rows[0]) {
+			return nil, ErrUnequalRows
+		}
+
+		m = append(m, NewVector(r.Dimension(), r...))
+	}
+
+	return m, nil
+}
+
+// NewDiagonalMatrix creates a new instance of a square Matrix with the
+// coordinates of a given vector along its diagonal, and zeros elsewhere.
+func NewDiagonalMatrix(v Vector) Matrix {
+	var (
+		m   Matrix
+		dim = v.Dimension()
+	)
+
+	for i := range dim {
+		r := NewZeroVector(dim)
+		r[i] = v[i]
+		m = append(m, r)
+	}
+
+	return m
+}
+
+// NewBlockMatrix creates a new instance of a Matrix from a grid of submatrices.
+// All blocks in a row of the grid must have the same number of rows, and each
+// row of the grid must add up to the same number of columns, otherwise an error
+// is returned.
+func NewBlockMatrix(blocks [][]Matrix) (Matrix, error) {
+	var rows []Matrix
+
+	for _, bs := range blocks {
+		r, err := HStack(bs...)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, r)
+	}
+
+	return VStack(rows...)
+}
+
+// NewVandermondeMatrix creates a new instance of a Vandermonde matrix from a
+// given vector v, with a row for each coordinate of v and a given number of
+// columns. Each row holds the increasing powers of its coordinate, starting
+// with the power of 0.
+func NewVandermondeMatrix(v Vector, cols uint) Matrix {
+	var m Matrix
+
+	for _, c := range v {
+		r := NewZeroVector(cols)
+		p := 1.0
+		for j := range cols {
+			r[j] = p
+			p *= c
+		}
+
+		m = append(m, r)
+	}
+
+	return m
+}
+
+// NewToeplitzMatrix creates a new instance of a Toeplitz matrix, i.e. a matrix
+// where each descending diagonal from left to right is constant. The first
+// column is given by c, and the first row by r. As both share the top left
+// coordinate, an error is returned if the first coordinates of c and r are not
+// equal.
+func NewToeplitzMatrix(c, r Vector) (Matrix, error) {
+	if len(c) == 0 || len(r) == 0 {
+		return nil, ErrInsufficientDim
+	}
+
+	if c[0] != r[0] {
+		return nil, ErrDiagMismatch
+	}
+
+	var m Matrix
+
+	for i := range c {
+		v := NewZeroVector(r.Dimension())
+		for j := range r {
+			if i >= j {
+				v[j] = c[i-j]
+			} else {
+				v[j] = r[j-i]
+			}
+		}
+
+		m = append(m, v)
+	}
+
+	return m, nil
+}
+
+// NewHilbertMatrix creates a new instance of a square Hilbert matrix with a
+// given dimension, where each entry at row i and column j is 1/(i+j+1). Hilbert
+// matrices are notoriously ill-conditioned, which makes them useful for testing
+// numerical algorithms.
+func NewHilbertMatrix(dim uint) Matrix {
+	var m Matrix
+
+	for i := range dim {
+		r := NewZeroVector(dim)
+		for j := range dim {
+			r[j] = 1 / float64(i+j+1)
+		}
+
+		m = append(m, r)
+	}
+
+	return m
+}
+
+// HStack creates and returns a new matrix by stacking the given matrices
+// horizontally, i.e. side by side. All matrices must have the same number of
+// rows, otherwise an error is returned.
+func HStack(ms ...Matrix) (Matrix, error) {
+	if len(ms) == 0 {
+		return nil, ErrNoMatrices
+	}
+
+	var rows int
+	for k, m := range ms {
+		r, _, err := m.shape()
+		if err != nil {
+			return nil, err
+		}
+
+		if k == 0 {
+			rows = r
+		} else if r != rows {
+			return nil, ErrInvalidShape
+		}
+	}
+
+	var hs Matrix
+
+	for i := range rows {
+		var cs []float64
+		for _, m := range ms {
+			cs = append(cs, m[i]...)
+		}
+
+		hs = append(hs, Vector(cs))
+	}
+
+	return hs, nil
+}
+
+// VStack creates and returns a new matrix by stacking the given matrices
+// vertically, i.e. on top of each other. All non-empty matrices must have the
+// same number of columns, otherwise an error is returned.
+func VStack(ms ...Matrix) (Matrix, error) {
+	if len(ms) == 0 {
+		return nil, ErrNoMatrices
+	}
+
+	var (
+		vs   Matrix
+		cols = -1
+	)
+
+	for _, m := range ms {
+		r, c, err := m.shape()
+		if err != nil {
+			return nil, err
+		}
+
+		if r == 0 {
+			continue
+		}
+
+		if cols == -1 {
+			cols = c
+		} else if c != cols {
+			return nil, ErrInvalidShape
+		}
+
+		for _, v := range m {
+			vs = append(vs, NewVector(v.Dimension(), v...))
+		}
+	}
+
+	return vs, nil
+}
+
+// Dims returns the number of rows and columns of the matrix. The number of
+// columns is taken from the first row.
+func (m Matrix) Dims() (uint, uint) {
+	if len(m) == 0 {
+		return 0, 0
+	}
+
+	return uint(len(m)), uint(len(m[0]))
+}
+
+// shape returns the number of rows and columns of the matrix, or an error if
+// the rows are not of equal length.
+func (m Matrix) shape() (int, int, error) {
+	if len(m) == 0 {
+		return 0, 0, nil
+	}
+
+	cols := len(m[0])
+	for _, r := range m {
+		if len(r) != cols {
+			return 0, 0, ErrUnequalRows
+		}
+	}
+
+	return len(m), cols, nil
+}
+
+// Kronecker creates and returns the Kronecker product of matrix m and matrix n,
+// i.e. a block matrix where each block is n scaled by the corresponding entry
+// of m. An m×n and a p×q matrix result in an mp×nq matrix.
+// |1  2|          |1  2|          |1  2  2  4|
+// |3  4|    ⊗     |2  3|    =>    |2  3  4  6|
+// -                               |3  6  4  8|
+// -                               |6  9  8 12|
+func (m Matrix) Kronecker(n Matrix) (Matrix, error) {
+	mr, mc, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	nr, nc, err := n.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	var k Matrix
+
+	for i := range mr * nr {
+		r := NewZeroVector(uint(mc * nc))
+		for j := range r {
+			r[j] = m[i/nr][j/nc] * n[i%nr][j%nc]
+		}
+
+		k = append(k, r)
+	}
+
+	return k, nil
+}
+
+// Mul creates and returns the matrix product of matrix m and matrix n. The
+// number of columns of m must equal the number of rows of n, otherwise an error
+// is returned.
+func (m Matrix) Mul(n Matrix) (Matrix, error) {
+	mr, mc, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	nr, nc, err := n.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if mc != nr {
+		return nil, ErrInvalidShape
+	}
+
+	var p Matrix
+
+	for i := range mr {
+		r := NewZeroVector(uint(nc))
+		for k := range mc {
+			if m[i][k] == 0 {
+				continue
+			}
+
+			for j := range nc {
+				r[j] += m[i][k] * n[k][j]
+			}
+		}
+
+		p = append(p, r)
+	}
+
+	return p, nil
+}
+
+// MulVec creates and returns the product of matrix m and column vector v. The
+// number of columns of m must equal the dimension of v, otherwise an error is
+// returned.
+func (m Matrix) MulVec(v Vector) (Vector, error) {
+	mr, mc, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if mr > 0 && mc != len(v) {
+		return nil, ErrInvalidShape
+	}
+
+	w := NewZeroVector(uint(mr))
+	for i := range mr {
+		w[i], _ = m[i].Dot(v)
+	}
+
+	return w, nil
+}
+
+// Solve solves the linear system m·x = b for x, and returns it. The system is
+// solved by Gaussian elimination with partial pivoting, i.e. at each column the
+// row with the largest absolute coordinate is swapped into the pivot position,
+// for numerical stability. The matrix m must be square and non-singular, and
+// is not altered.
+func (m Matrix) Solve(b Vector) (Vector, error) {
+	bs := make(Matrix, len(b))
+	for i, c := range b {
+		bs[i] = NewVector(1, c)
+	}
+
+	xs, err := m.solveAll(bs)
+	if err != nil {
+		return nil, err
+	}
+
+	x := NewZeroVector(uint(len(xs)))
+	for i := range xs {
+		x[i] = xs[i][0]
+	}
+
+	return x, nil
+}
+
+// Inverse creates and returns the inverse of matrix m, i.e. the matrix n such
+// that m·n = n·m = I, by solving m·n = I. The matrix m must be square and
+// non-singular.
+func (m Matrix) Inverse() (Matrix, error) {
+	n, _, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.solveAll(NewIdentityMatrix(uint(n), uint(n)))
+}
+
+// solveAll solves the linear system m·X = B for X, where each column of B is a
+// right hand side, by Gaussian elimination with partial pivoting on the
+// augmented matrix [m | B]. The matrix m is taken as singular if a pivot is no
+// larger than n·ε·max|mᵢⱼ|, where ε is the machine epsilon, so the test does
+// not depend on the scale of m.
+func (m Matrix) solveAll(b Matrix) (Matrix, error) {
+	n, c, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if n != c {
+		return nil, ErrNotSquare
+	}
+
+	br, k, err := b.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if br != n {
+		return nil, ErrInvalidShape
+	}
+
+	// build the augmented matrix [m | b], and find the largest absolute
+	// coordinate of m for the singularity tolerance
+	a := make(Matrix, n)
+	var scale float64
+	for i := range n {
+		a[i] = NewVector(uint(n+k), m[i]...)
+		copy(a[i][n:], b[i])
+		for _, c := range m[i] {
+			scale = math.Max(scale, math.Abs(c))
+		}
+	}
+
+	tol := float64(n) * 0x1p-52 * scale
+
+	for j := range n {
+		p := j
+		for i := j + 1; i < n; i++ {
+			if math.Abs(a[i][j]) > math.Abs(a[p][j]) {
+				p = i
+			}
+		}
+
+		if math.Abs(a[p][j]) <= tol {
+			return nil, ErrSingular
+		}
+
+		a[j], a[p] = a[p], a[j]
+
+		for i := j + 1; i < n; i++ {
+			f := a[i][j] / a[j][j]
+			for l := j; l < n+k; l++ {
+				a[i][l] -= f * a[j][l]
+			}
+		}
+	}
+
+	// back substitution for each right hand side
+	x := NewMatrix(uint(n), uint(k))
+	for l := range k {
+		for i := n - 1; i >= 0; i-- {
+			s := a[i][n+l]
+			for j := i + 1; j < n; j++ {
+				s -= a[i][j] * x[j][l]
+			}
+
+			x[i][l] = s / a[i][i]
+		}
+	}
+
+	return x, nil
+}
+
 // Transpose creates and returns a new matrix with rows and columns transposed.
 // |1.0  2.0  3.0|          |1.0  4.0|
 // |4.0  5.0  6.0|    =>    |2.0  5.0|
@@ -81,7 +516,7 @@ func (m Matrix) Transpose() Matrix {
 	var mt Matrix
 
 	for i := range cols {
-		var cs = make([]float64, cols)
+		var cs = make([]float64, rows)
 		for j := range rows {
 			cs[j] = m[j][i]
 		}
@@ -127,12 +562,8 @@ func (m Matrix) Transpose() Matrix {
 // func (m *Matrix) Determinant																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																																					() float64 {
 // }
 
-// func (m *Matrix) Inverse() error {
-// 	if m.rows != m.cols {
-// 		return ErrNotSquare
-// 	}
-// }
-
+// Print writes each coordinate of the matrix to stdout, row by row. Use Fprint
+// to print to any writer with configurable options.
 func (m Matrix) Print() {
 	for _, r := range m {
 		for _, c := range r {
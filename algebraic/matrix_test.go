@@ -1,6 +1,7 @@
 package algebraic_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/madshov/data-structures/algebraic"
@@ -151,6 +152,17 @@ func TestTranspose(t *testing.T) {
 				3, 6,
 			),
 		},
+		"should return a transposed 2x3-matrix from a 3x2-matrix": {
+			m: algebraic.NewMatrix(3, 2,
+				1, 2,
+				3, 4,
+				5, 6,
+			),
+			want: algebraic.NewMatrix(2, 3,
+				1, 3, 5,
+				2, 4, 6,
+			),
+		},
 		"should return a transposed 3x3-matrix from a 3x3-matrix": {
 			m: algebraic.NewMatrix(3, 3,
 				1, 2, 3,
@@ -177,3 +189,459 @@ func TestTranspose(t *testing.T) {
 	}
 
 }
+
+func TestNewMatrixFromRows(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		rows    []algebraic.Vector
+		want    algebraic.Matrix
+		wantErr error
+	}{
+		"should return a new 2x3-matrix from two rows": {
+			rows: []algebraic.Vector{
+				algebraic.NewVector(3, 1, 2, 3),
+				algebraic.NewVector(3, 4, 5, 6),
+			},
+			want: algebraic.NewMatrix(2, 3,
+				1, 2, 3,
+				4, 5, 6,
+			),
+		},
+		"should return an error given rows of unequal length": {
+			rows: []algebraic.Vector{
+				algebraic.NewVector(3, 1, 2, 3),
+				algebraic.NewVector(2, 4, 5),
+			},
+			wantErr: algebraic.ErrUnequalRows,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.NewMatrixFromRows(test.rows...)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.EqualValues(test.want, got)
+			}
+		})
+	}
+}
+
+func TestNewDiagonalMatrix(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		v    algebraic.Vector
+		want algebraic.Matrix
+	}{
+		"should return a 3x3-matrix with the vector along the diagonal": {
+			v: algebraic.NewVector(3, 1, 2, 3),
+			want: algebraic.NewMatrix(3, 3,
+				1, 0, 0,
+				0, 2, 0,
+				0, 0, 3,
+			),
+		},
+		"should return a new zero matrix": {
+			v:    algebraic.NewZeroVector(0),
+			want: algebraic.NewMatrix(0, 0),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := algebraic.NewDiagonalMatrix(test.v)
+			assert.EqualValues(test.want, got)
+		})
+	}
+}
+
+func TestNewBlockMatrix(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		blocks  [][]algebraic.Matrix
+		want    algebraic.Matrix
+		wantErr error
+	}{
+		"should return a 3x3-matrix from four blocks": {
+			blocks: [][]algebraic.Matrix{
+				{algebraic.NewMatrix(2, 2, 1, 2, 3, 4), algebraic.NewMatrix(2, 1, 5, 6)},
+				{algebraic.NewMatrix(1, 2, 7, 8), algebraic.NewMatrix(1, 1, 9)},
+			},
+			want: algebraic.NewMatrix(3, 3,
+				1, 2, 5,
+				3, 4, 6,
+				7, 8, 9,
+			),
+		},
+		"should return an error given blocks with unequal rows": {
+			blocks: [][]algebraic.Matrix{
+				{algebraic.NewMatrix(2, 2, 1, 2, 3, 4), algebraic.NewMatrix(1, 1, 5)},
+			},
+			wantErr: algebraic.ErrInvalidShape,
+		},
+		"should return an error given block rows with unequal columns": {
+			blocks: [][]algebraic.Matrix{
+				{algebraic.NewMatrix(1, 2, 1, 2)},
+				{algebraic.NewMatrix(1, 3, 1, 2, 3)},
+			},
+			wantErr: algebraic.ErrInvalidShape,
+		},
+		"should return an error given no blocks": {
+			wantErr: algebraic.ErrNoMatrices,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.NewBlockMatrix(test.blocks)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.EqualValues(test.want, got)
+			}
+		})
+	}
+}
+
+func TestHStack(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		ms      []algebraic.Matrix
+		want    algebraic.Matrix
+		wantErr error
+	}{
+		"should stack a 2x2-matrix and a 2x1-matrix side by side": {
+			ms: []algebraic.Matrix{
+				algebraic.NewMatrix(2, 2, 1, 2, 4, 5),
+				algebraic.NewMatrix(2, 1, 3, 6),
+			},
+			want: algebraic.NewMatrix(2, 3,
+				1, 2, 3,
+				4, 5, 6,
+			),
+		},
+		"should return an error given matrices with unequal rows": {
+			ms: []algebraic.Matrix{
+				algebraic.NewMatrix(2, 2, 1, 2, 4, 5),
+				algebraic.NewMatrix(1, 1, 3),
+			},
+			wantErr: algebraic.ErrInvalidShape,
+		},
+		"should return an error given a ragged matrix": {
+			ms: []algebraic.Matrix{
+				{algebraic.NewVector(2, 1, 2), algebraic.NewVector(1, 3)},
+			},
+			wantErr: algebraic.ErrUnequalRows,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.HStack(test.ms...)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.EqualValues(test.want, got)
+			}
+		})
+	}
+}
+
+func TestVStack(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		ms      []algebraic.Matrix
+		want    algebraic.Matrix
+		wantErr error
+	}{
+		"should stack a 1x3-matrix on top of a 2x3-matrix": {
+			ms: []algebraic.Matrix{
+				algebraic.NewMatrix(1, 3, 1, 2, 3),
+				algebraic.NewMatrix(2, 3, 4, 5, 6, 7, 8, 9),
+			},
+			want: algebraic.NewMatrix(3, 3,
+				1, 2, 3,
+				4, 5, 6,
+				7, 8, 9,
+			),
+		},
+		"should return an error given matrices with unequal columns": {
+			ms: []algebraic.Matrix{
+				algebraic.NewMatrix(1, 3, 1, 2, 3),
+				algebraic.NewMatrix(1, 2, 4, 5),
+			},
+			wantErr: algebraic.ErrInvalidShape,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.VStack(test.ms...)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.EqualValues(test.want, got)
+			}
+		})
+	}
+}
+
+func TestKronecker(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m, n algebraic.Matrix
+		want algebraic.Matrix
+	}{
+		"should return the 4x4 kronecker product of two 2x2-matrices": {
+			m: algebraic.NewMatrix(2, 2, 1, 2, 3, 4),
+			n: algebraic.NewMatrix(2, 2, 1, 2, 2, 3),
+			want: algebraic.NewMatrix(4, 4,
+				1, 2, 2, 4,
+				2, 3, 4, 6,
+				3, 6, 4, 8,
+				6, 9, 8, 12,
+			),
+		},
+		"should return the 2x6 kronecker product of a 1x2- and a 2x3-matrix": {
+			m: algebraic.NewMatrix(1, 2, 1, 2),
+			n: algebraic.NewMatrix(2, 3, 1, 2, 3, 4, 5, 6),
+			want: algebraic.NewMatrix(2, 6,
+				1, 2, 3, 2, 4, 6,
+				4, 5, 6, 8, 10, 12,
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.Kronecker(test.n)
+			assert.NoError(err)
+			assert.EqualValues(test.want, got)
+		})
+	}
+}
+
+func TestNewVandermondeMatrix(t *testing.T) {
+	assert := assert.New(t)
+	got := algebraic.NewVandermondeMatrix(algebraic.NewVector(3, 1, 2, 3), 4)
+	want := algebraic.NewMatrix(3, 4,
+		1, 1, 1, 1,
+		1, 2, 4, 8,
+		1, 3, 9, 27,
+	)
+	assert.EqualValues(want, got)
+}
+
+func TestNewToeplitzMatrix(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		c, r    algebraic.Vector
+		want    algebraic.Matrix
+		wantErr error
+	}{
+		"should return a 3x4 toeplitz matrix": {
+			c: algebraic.NewVector(3, 1, 2, 3),
+			r: algebraic.NewVector(4, 1, 4, 5, 6),
+			want: algebraic.NewMatrix(3, 4,
+				1, 4, 5, 6,
+				2, 1, 4, 5,
+				3, 2, 1, 4,
+			),
+		},
+		"should return an error given mismatching first coordinates": {
+			c:       algebraic.NewVector(2, 1, 2),
+			r:       algebraic.NewVector(2, 2, 3),
+			wantErr: algebraic.ErrDiagMismatch,
+		},
+		"should return an error given an empty column": {
+			c:       algebraic.NewZeroVector(0),
+			r:       algebraic.NewVector(2, 2, 3),
+			wantErr: algebraic.ErrInsufficientDim,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.NewToeplitzMatrix(test.c, test.r)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.EqualValues(test.want, got)
+			}
+		})
+	}
+}
+
+func TestNewHilbertMatrix(t *testing.T) {
+	assert := assert.New(t)
+	got := algebraic.NewHilbertMatrix(3)
+	want := algebraic.NewMatrix(3, 3,
+		1, 1.0/2, 1.0/3,
+		1.0/2, 1.0/3, 1.0/4,
+		1.0/3, 1.0/4, 1.0/5,
+	)
+	assert.EqualValues(want, got)
+}
+
+func TestMatrixMul(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m, n    algebraic.Matrix
+		want    algebraic.Matrix
+		wantErr error
+	}{
+		"should multiply a 2x3-matrix with a 3x2-matrix": {
+			m: algebraic.NewMatrix(2, 3,
+				1, 2, 3,
+				4, 5, 6,
+			),
+			n: algebraic.NewMatrix(3, 2,
+				7, 8,
+				9, 10,
+				11, 12,
+			),
+			want: algebraic.NewMatrix(2, 2,
+				58, 64,
+				139, 154,
+			),
+		},
+		"should return the same matrix when multiplying with the identity": {
+			m:    algebraic.NewMatrix(2, 2, 1, 2, 3, 4),
+			n:    algebraic.NewIdentityMatrix(2, 2),
+			want: algebraic.NewMatrix(2, 2, 1, 2, 3, 4),
+		},
+		"should return an error given incompatible shapes": {
+			m:       algebraic.NewMatrix(2, 3),
+			n:       algebraic.NewMatrix(2, 3),
+			wantErr: algebraic.ErrInvalidShape,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.Mul(test.n)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.EqualValues(test.want, got)
+			}
+		})
+	}
+}
+
+func TestMulVec(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m       algebraic.Matrix
+		v       algebraic.Vector
+		want    algebraic.Vector
+		wantErr error
+	}{
+		"should multiply a 2x3-matrix with a 3-dimensional vector": {
+			m: algebraic.NewMatrix(2, 3,
+				1, 2, 3,
+				4, 5, 6,
+			),
+			v:    algebraic.NewVector(3, 1, 0, -1),
+			want: algebraic.NewVector(2, -2, -2),
+		},
+		"should return an error given a vector of wrong dimension": {
+			m:       algebraic.NewMatrix(2, 3),
+			v:       algebraic.NewVector(2, 1, 0),
+			wantErr: algebraic.ErrInvalidShape,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.MulVec(test.v)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.EqualValues(test.want, got)
+			}
+		})
+	}
+}
+
+func TestSolve(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m       algebraic.Matrix
+		b       algebraic.Vector
+		want    algebraic.Vector
+		wantErr error
+	}{
+		"should solve a 3x3 system": {
+			m: algebraic.NewMatrix(3, 3,
+				2, 1, -1,
+				-3, -1, 2,
+				-2, 1, 2,
+			),
+			b:    algebraic.NewVector(3, 8, -11, -3),
+			want: algebraic.NewVector(3, 2, 3, -1),
+		},
+		"should solve a system requiring a row swap": {
+			m: algebraic.NewMatrix(2, 2,
+				0, 1,
+				1, 0,
+			),
+			b:    algebraic.NewVector(2, 2, 3),
+			want: algebraic.NewVector(2, 3, 2),
+		},
+		"should return an error given a singular matrix": {
+			m: algebraic.NewMatrix(2, 2,
+				1, 2,
+				2, 4,
+			),
+			b:       algebraic.NewVector(2, 1, 2),
+			wantErr: algebraic.ErrSingular,
+		},
+		"should solve a system with a tiny scaled identity matrix": {
+			m: algebraic.NewMatrix(2, 2,
+				1e-15, 0,
+				0, 1e-15,
+			),
+			b:    algebraic.NewVector(2, 1e-15, -2e-15),
+			want: algebraic.NewVector(2, 1, -2),
+		},
+		"should return an error given a large nearly singular matrix": {
+			m: algebraic.NewMatrix(2, 2,
+				1e10, 2e10,
+				1e10, math.Nextafter(2e10, 3e10),
+			),
+			b:       algebraic.NewVector(2, 1, 2),
+			wantErr: algebraic.ErrSingular,
+		},
+		"should return an error given a zero matrix": {
+			m:       algebraic.NewMatrix(2, 2),
+			b:       algebraic.NewVector(2, 1, 2),
+			wantErr: algebraic.ErrSingular,
+		},
+		"should return an error given a non-square matrix": {
+			m:       algebraic.NewMatrix(2, 3),
+			b:       algebraic.NewVector(2, 1, 2),
+			wantErr: algebraic.ErrNotSquare,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.Solve(test.b)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.InDeltaSlice(test.want, got, 1e-9)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package algebraic
+
+import (
+	"errors"
+	"math"
+)
+
+// Various errors a matrix function can return.
+var (
+	ErrNoConvergence = errors.New("matrix function did not converge")
+	ErrNotFinite     = errors.New("matrix has an infinite or NaN norm")
+)
+
+// padeCoeffs are the coefficients of the [6/6] Padé approximant of e^x, i.e.
+// cₖ = (12-k)!·6! / (12!·k!·(6-k)!).
+var padeCoeffs = [7]float64{
+	1,
+	1.0 / 2,
+	5.0 / 44,
+	1.0 / 66,
+	1.0 / 792,
+	1.0 / 15840,
+	1.0 / 665280,
+}
+
+// Pow creates and returns the matrix m raised to the power of k, by repeated
+// squaring. Rather than multiplying m by itself k times, m is squared once per
+// bit of k, and the squares corresponding to set bits are multiplied into the
+// result, which takes O(lg k) matrix products. A power of 0 is the identity,
+// and a negative power is the power of the inverse of m. For an adjacency
+// matrix m, the entry at row i and column j of m^k counts the number of paths
+// of length k from vertex i to vertex j.
+func (m Matrix) Pow(k int) (Matrix, error) {
+	n, c, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if n != c {
+		return nil, ErrNotSquare
+	}
+
+	// the exponent is negated as unsigned, which also holds |math.MinInt|
+	b, e := m, uint(k)
+	if k < 0 {
+		if b, err = m.Inverse(); err != nil {
+			return nil, err
+		}
+
+		e = -e
+	}
+
+	p := NewIdentityMatrix(uint(n), uint(n))
+	for e > 0 {
+		if e&1 == 1 {
+			p, _ = p.Mul(b)
+		}
+
+		e >>= 1
+		if e > 0 {
+			b, _ = b.Mul(b)
+		}
+	}
+
+	return p, nil
+}
+
+// Exp creates and returns the matrix exponential of m, i.e. the sum of the
+// series I + m + m²/2! + m³/3! + ..., using scaling and squaring with a [6/6]
+// Padé approximant. As e^m = (e^(m/2ˢ))^(2ˢ), m is first scaled down by a power
+// of 2 until its norm is at most 1/2, where the Padé approximant
+// r(m) = q(m)⁻¹·p(m) is accurate to machine precision. The result is then
+// squared s times. The solution of the linear ODE system dy/dt = m·y is
+// y(t) = e^(m·t)·y(0), and the transition matrix of a continuous-time Markov
+// chain with rate matrix m is e^(m·t). If the norm of m is infinite or NaN, an
+// error is returned.
+func (m Matrix) Exp() (Matrix, error) {
+	n, c, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if n != c {
+		return nil, ErrNotSquare
+	}
+
+	norm := m.norm1()
+	if math.IsInf(norm, 0) || math.IsNaN(norm) {
+		return nil, ErrNotFinite
+	}
+
+	// choose s such that ‖m/2ˢ‖ ≤ 1/2
+	var s int
+	if norm > 0.5 {
+		s = max(0, int(math.Ceil(math.Log2(norm/0.5))))
+	}
+
+	a := m.scaled(1 / math.Exp2(float64(s)))
+
+	// evaluate p(a) = Σ cₖ·aᵏ and q(a) = Σ (-1)ᵏ·cₖ·aᵏ
+	id := NewIdentityMatrix(uint(n), uint(n))
+	p := id.scaled(padeCoeffs[0])
+	q := id.scaled(padeCoeffs[0])
+	ak := id
+
+	for k := 1; k < len(padeCoeffs); k++ {
+		ak, _ = ak.Mul(a)
+		sign := 1.0
+		if k%2 == 1 {
+			sign = -1
+		}
+
+		p.addScaled(ak, padeCoeffs[k])
+		q.addScaled(ak, sign*padeCoeffs[k])
+	}
+
+	e, err := q.solveAll(p)
+	if err != nil {
+		return nil, err
+	}
+
+	for range s {
+		e, _ = e.Mul(e)
+	}
+
+	return e, nil
+}
+
+// Sqrt creates and returns the principal square root of m, i.e. the unique
+// matrix x such that x·x = m whose eigenvalues all have positive real part,
+// using the Denman-Beavers iteration:
+//
+//	Yₖ₊₁ = (Yₖ + Zₖ⁻¹)/2,  Zₖ₊₁ = (Zₖ + Yₖ⁻¹)/2,  Y₀ = m,  Z₀ = I
+//
+// where Yₖ converges quadratically to the square root of m, and Zₖ to its
+// inverse. The principal square root exists if m has no eigenvalues on the
+// closed negative real axis. If m is singular or the iteration does not
+// converge, an error is returned.
+func (m Matrix) Sqrt() (Matrix, error) {
+	n, c, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if n != c {
+		return nil, ErrNotSquare
+	}
+
+	y := m.scaled(1)
+	z := NewIdentityMatrix(uint(n), uint(n))
+
+	for range 100 {
+		yi, err := y.Inverse()
+		if err != nil {
+			return nil, err
+		}
+
+		zi, err := z.Inverse()
+		if err != nil {
+			return nil, err
+		}
+
+		yn := y.scaled(0.5)
+		yn.addScaled(zi, 0.5)
+		z = z.scaled(0.5)
+		z.addScaled(yi, 0.5)
+
+		d := yn.scaled(1)
+		d.addScaled(y, -1)
+		y = yn
+
+		if d.norm1() <= 1e-14*y.norm1() {
+			return y, nil
+		}
+	}
+
+	return nil, ErrNoConvergence
+}
+
+// Log creates and returns the principal logarithm of m, i.e. the unique matrix
+// x such that e^x = m whose eigenvalues all have imaginary part in (-π, π),
+// using inverse scaling and squaring. As log(m) = 2ᵏ·log(m^(1/2ᵏ)), square
+// roots are taken until m^(1/2ᵏ) is close to the identity, at which point the
+// series log(I+x) = x - x²/2 + x³/3 - ... converges quickly. The principal
+// logarithm exists if m has no eigenvalues on the closed negative real axis.
+func (m Matrix) Log() (Matrix, error) {
+	n, c, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	if n != c {
+		return nil, ErrNotSquare
+	}
+
+	id := NewIdentityMatrix(uint(n), uint(n))
+	a := m
+	var k int
+
+	for {
+		x := a.scaled(1)
+		x.addScaled(id, -1)
+		if x.norm1() <= 0.25 {
+			break
+		}
+
+		if k == 64 {
+			return nil, ErrNoConvergence
+		}
+
+		if a, err = a.Sqrt(); err != nil {
+			return nil, err
+		}
+
+		k++
+	}
+
+	x := a.scaled(1)
+	x.addScaled(id, -1)
+
+	l := NewMatrix(uint(n), uint(n))
+	xj := id
+	for j := 1; j <= 200; j++ {
+		xj, _ = xj.Mul(x)
+
+		sign := 1.0
+		if j%2 == 0 {
+			sign = -1
+		}
+
+		l.addScaled(xj, sign/float64(j))
+
+		if xj.norm1()/float64(j) <= 1e-17*l.norm1() {
+			return l.scaled(math.Exp2(float64(k))), nil
+		}
+	}
+
+	return nil, ErrNoConvergence
+}
+
+// norm1 returns the 1-norm of the matrix, i.e. the maximum absolute column sum.
+func (m Matrix) norm1() float64 {
+	var norm float64
+	if len(m) == 0 {
+		return 0
+	}
+
+	for j := range m[0] {
+		var s float64
+		for i := range m {
+			s += math.Abs(m[i][j])
+		}
+
+		norm = math.Max(norm, s)
+	}
+
+	return norm
+}
+
+// scaled creates and returns a copy of the matrix with each coordinate
+// multiplied by a given scalar value.
+func (m Matrix) scaled(scalar float64) Matrix {
+	s := make(Matrix, len(m))
+	for i, r := range m {
+		s[i] = NewVector(r.Dimension(), r...)
+		s[i].Scale(scalar)
+	}
+
+	return s
+}
+
+// addScaled adds matrix n multiplied by a given scalar value to matrix m, in
+// place. Both matrices must have the same shape.
+func (m Matrix) addScaled(n Matrix, scalar float64) {
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] += scalar * n[i][j]
+		}
+	}
+}
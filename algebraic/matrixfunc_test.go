@@ -0,0 +1,236 @@
+package algebraic_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// assertMatrixInDelta asserts that two matrices have equal shape, and that
+// their coordinates are within a given delta.
+func assertMatrixInDelta(t *testing.T, want, got algebraic.Matrix, delta float64) {
+	assert.Len(t, got, len(want))
+	for i := range want {
+		assert.InDeltaSlice(t, want[i], got[i], delta)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	assert := assert.New(t)
+	m := algebraic.NewMatrix(3, 3,
+		2, -1, 0,
+		-1, 2, -1,
+		0, -1, 2,
+	)
+
+	got, err := m.Inverse()
+	assert.NoError(err)
+	assertMatrixInDelta(t, algebraic.NewMatrix(3, 3,
+		0.75, 0.5, 0.25,
+		0.5, 1, 0.5,
+		0.25, 0.5, 0.75,
+	), got, 1e-12)
+
+	_, err = algebraic.NewMatrix(2, 2, 1, 2, 2, 4).Inverse()
+	assert.ErrorIs(err, algebraic.ErrSingular)
+
+	// the singularity test is relative to the scale of the matrix
+	got, err = algebraic.NewDiagonalMatrix(algebraic.NewVector(3, 1e-15, 1e-15, 1e-15)).Inverse()
+	assert.NoError(err)
+	assertMatrixInDelta(t, algebraic.NewDiagonalMatrix(algebraic.NewVector(3, 1e15, 1e15, 1e15)), got, 1)
+}
+
+func TestPow(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m       algebraic.Matrix
+		k       int
+		want    algebraic.Matrix
+		wantErr error
+	}{
+		"should return fibonacci numbers": {
+			m:    algebraic.NewMatrix(2, 2, 1, 1, 1, 0),
+			k:    10,
+			want: algebraic.NewMatrix(2, 2, 89, 55, 55, 34),
+		},
+		"should count the paths of length 3 in a directed triangle": {
+			m: algebraic.NewMatrix(3, 3,
+				0, 1, 1,
+				0, 0, 1,
+				1, 0, 0,
+			),
+			k: 3,
+			want: algebraic.NewMatrix(3, 3,
+				1, 1, 1,
+				0, 1, 1,
+				1, 0, 1,
+			),
+		},
+		"should return the identity given a power of 0": {
+			m:    algebraic.NewMatrix(2, 2, 1, 2, 3, 4),
+			k:    0,
+			want: algebraic.NewIdentityMatrix(2, 2),
+		},
+		"should return the inverse squared given a power of -2": {
+			m:    algebraic.NewMatrix(2, 2, 2, 0, 0, 4),
+			k:    -2,
+			want: algebraic.NewMatrix(2, 2, 0.25, 0, 0, 0.0625),
+		},
+		"should return the identity given the most negative even power": {
+			m:    algebraic.NewDiagonalMatrix(algebraic.NewVector(2, 1, -1)),
+			k:    math.MinInt,
+			want: algebraic.NewIdentityMatrix(2, 2),
+		},
+		"should return the matrix itself given the largest odd power": {
+			m:    algebraic.NewDiagonalMatrix(algebraic.NewVector(2, 1, -1)),
+			k:    math.MaxInt,
+			want: algebraic.NewDiagonalMatrix(algebraic.NewVector(2, 1, -1)),
+		},
+		"should return an error given a non-square matrix": {
+			m:       algebraic.NewMatrix(2, 3),
+			k:       2,
+			wantErr: algebraic.ErrNotSquare,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.Pow(test.k)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assertMatrixInDelta(t, test.want, got, 1e-12)
+			}
+		})
+	}
+}
+
+func TestExp(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m    algebraic.Matrix
+		want algebraic.Matrix
+	}{
+		"should return the identity given a zero matrix": {
+			m:    algebraic.NewMatrix(2, 2),
+			want: algebraic.NewIdentityMatrix(2, 2),
+		},
+		"should exponentiate each coordinate of a diagonal matrix": {
+			m:    algebraic.NewDiagonalMatrix(algebraic.NewVector(2, 1, -3)),
+			want: algebraic.NewDiagonalMatrix(algebraic.NewVector(2, math.E, math.Exp(-3))),
+		},
+		"should return a rotation given a skew-symmetric matrix": {
+			m: algebraic.NewMatrix(2, 2, 0, -2, 2, 0),
+			want: algebraic.NewMatrix(2, 2,
+				math.Cos(2), -math.Sin(2),
+				math.Sin(2), math.Cos(2),
+			),
+		},
+		"should return the transition matrix of a two-state markov chain": {
+			// rates a=1 and b=2 from state 0 to 1 and 1 to 0, at t=1
+			m: algebraic.NewMatrix(2, 2, -1, 1, 2, -2),
+			want: algebraic.NewMatrix(2, 2,
+				(2+math.Exp(-3))/3, (1-math.Exp(-3))/3,
+				(2-2*math.Exp(-3))/3, (1+2*math.Exp(-3))/3,
+			),
+		},
+		"should exponentiate a nilpotent matrix exactly": {
+			m: algebraic.NewMatrix(3, 3,
+				0, 10, 0,
+				0, 0, 10,
+				0, 0, 0,
+			),
+			want: algebraic.NewMatrix(3, 3,
+				1, 10, 50,
+				0, 1, 10,
+				0, 0, 1,
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.Exp()
+			assert.NoError(err)
+			assertMatrixInDelta(t, test.want, got, 1e-10)
+		})
+	}
+}
+
+func TestExpNotFinite(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		m algebraic.Matrix
+	}{
+		"should return an error given an infinite coordinate": {
+			m: algebraic.NewMatrix(2, 2, 1, math.Inf(1), 0, 1),
+		},
+		"should return an error given a negative infinite coordinate": {
+			m: algebraic.NewMatrix(2, 2, math.Inf(-1), 0, 0, 1),
+		},
+		"should return an error given a NaN coordinate": {
+			m: algebraic.NewMatrix(2, 2, 1, 0, math.NaN(), 1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.m.Exp()
+			assert.ErrorIs(err, algebraic.ErrNotFinite)
+			assert.Nil(got)
+		})
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	assert := assert.New(t)
+	m := algebraic.NewMatrix(3, 3,
+		4, 1, 0,
+		1, 4, 1,
+		0, 1, 4,
+	)
+
+	x, err := m.Sqrt()
+	assert.NoError(err)
+	got, _ := x.Mul(x)
+	assertMatrixInDelta(t, m, got, 1e-10)
+
+	x, err = algebraic.NewDiagonalMatrix(algebraic.NewVector(2, 4, 9)).Sqrt()
+	assert.NoError(err)
+	assertMatrixInDelta(t, algebraic.NewDiagonalMatrix(algebraic.NewVector(2, 2, 3)), x, 1e-12)
+
+	_, err = algebraic.NewMatrix(2, 2, 1, 0, 0, 0).Sqrt()
+	assert.ErrorIs(err, algebraic.ErrSingular)
+}
+
+func TestLog(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]algebraic.Matrix{
+		"should invert exp of a diagonal matrix": algebraic.NewDiagonalMatrix(algebraic.NewVector(2, 1, -3)),
+		"should invert exp of a general matrix": algebraic.NewMatrix(3, 3,
+			0.5, 1, 0,
+			-0.2, 0.1, 0.3,
+			0, 0.4, -1,
+		),
+		"should invert exp of a rotation": algebraic.NewMatrix(2, 2, 0, -2, 2, 0),
+	}
+
+	for name, m := range tests {
+		t.Run(name, func(t *testing.T) {
+			e, err := m.Exp()
+			assert.NoError(err)
+
+			got, err := e.Log()
+			assert.NoError(err)
+			assertMatrixInDelta(t, m, got, 1e-9)
+		})
+	}
+
+	got, err := algebraic.NewIdentityMatrix(3, 3).Log()
+	assert.NoError(err)
+	assertMatrixInDelta(t, algebraic.NewMatrix(3, 3), got, 1e-15)
+}
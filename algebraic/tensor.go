@@ -0,0 +1,581 @@
+package algebraic
+
+import (
+	"errors"
+	"math"
+)
+
+// Various errors a tensor function can return.
+var (
+	ErrDataMismatch       = errors.New("tensor data does not match shape")
+	ErrNegativeShape      = errors.New("tensor shape cannot be negative")
+	ErrIndexOutOfRange    = errors.New("tensor index is out of range")
+	ErrAxisOutOfRange     = errors.New("tensor axis is out of range")
+	ErrInvalidPermutation = errors.New("axes are not a permutation of the tensor axes")
+	ErrNotBroadcastable   = errors.New("tensor shapes cannot be broadcast together")
+	ErrInvalidRange       = errors.New("tensor range is invalid")
+	ErrInvalidRank        = errors.New("tensor rank is not as required")
+)
+
+// Tensor defines an N-dimensional array of floating point coordinates. The
+// coordinates are stored in a flat slice, and the position of the coordinate
+// at index (i₀, i₁, ..., iₙ) is given by offset + i₀·stride₀ + ... + iₙ·strideₙ.
+// This allows operations such as transposing and slicing to return views of
+// the same underlying data, without copying it. A new tensor is always stored
+// in row-major order, i.e. the last axis varies fastest.
+type Tensor struct {
+	data    []float64
+	shape   []int
+	strides []int
+	offset  int
+}
+
+// Range defines a range of indices along an axis of a tensor, from Start up to,
+// but not including, End, in steps of Step. A Step of 0 is treated as 1, and an
+// End of 0 as the length of the axis. Negative values of Start and End count
+// from the end of the axis.
+type Range struct {
+	Start, End, Step int
+}
+
+// NewTensor creates a new instance of a Tensor with a given shape and a slice
+// of coordinates in row-major order. If no coordinates are given, the tensor
+// is zero-filled. Otherwise, the number of coordinates must equal the product
+// of the shape, and an error is returned if not.
+func NewTensor(shape []int, coords ...float64) (*Tensor, error) {
+	n := 1
+	for _, d := range shape {
+		if d < 0 {
+			return nil, ErrNegativeShape
+		}
+
+		n *= d
+	}
+
+	data := make([]float64, n)
+	if len(coords) > 0 {
+		if len(coords) != n {
+			return nil, ErrDataMismatch
+		}
+
+		copy(data, coords)
+	}
+
+	return &Tensor{
+		data:    data,
+		shape:   append([]int(nil), shape...),
+		strides: rowMajorStrides(shape),
+	}, nil
+}
+
+// NewTensorFromVector creates a new 1-dimensional tensor with the coordinates
+// of a given vector.
+func NewTensorFromVector(v Vector) *Tensor {
+	t, _ := NewTensor([]int{len(v)}, v...)
+	return t
+}
+
+// NewTensorFromMatrix creates a new 2-dimensional tensor with the coordinates
+// of a given matrix. If the rows of the matrix are not of equal length, an
+// error is returned.
+func NewTensorFromMatrix(m Matrix) (*Tensor, error) {
+	rows, cols, err := m.shape()
+	if err != nil {
+		return nil, err
+	}
+
+	t, _ := NewTensor([]int{rows, cols})
+	for i, r := range m {
+		copy(t.data[i*cols:], r)
+	}
+
+	return t, nil
+}
+
+// rowMajorStrides returns the strides of a contiguous row-major tensor with a
+// given shape.
+func rowMajorStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	s := 1
+	for i := len(shape) - 1; i >= 0; i-- {
+		strides[i] = s
+		s *= shape[i]
+	}
+
+	return strides
+}
+
+// Shape returns the length of each axis of the tensor.
+func (t *Tensor) Shape() []int {
+	return append([]int(nil), t.shape...)
+}
+
+// Rank returns the number of axes of the tensor.
+func (t *Tensor) Rank() int {
+	return len(t.shape)
+}
+
+// Size returns the total number of coordinates in the tensor.
+func (t *Tensor) Size() int {
+	n := 1
+	for _, d := range t.shape {
+		n *= d
+	}
+
+	return n
+}
+
+// offsetOf returns the position in the underlying data of a given index, or an
+// error if the index is out of range.
+func (t *Tensor) offsetOf(idx []int) (int, error) {
+	if len(idx) != len(t.shape) {
+		return 0, ErrIndexOutOfRange
+	}
+
+	off := t.offset
+	for k, i := range idx {
+		if i < 0 || i >= t.shape[k] {
+			return 0, ErrIndexOutOfRange
+		}
+
+		off += i * t.strides[k]
+	}
+
+	return off, nil
+}
+
+// At returns the coordinate at a given index.
+func (t *Tensor) At(idx ...int) (float64, error) {
+	off, err := t.offsetOf(idx)
+	if err != nil {
+		return 0, err
+	}
+
+	return t.data[off], nil
+}
+
+// Set sets the coordinate at a given index. As views share data, the change is
+// visible through every view of the same tensor.
+func (t *Tensor) Set(val float64, idx ...int) error {
+	off, err := t.offsetOf(idx)
+	if err != nil {
+		return err
+	}
+
+	t.data[off] = val
+	return nil
+}
+
+// each calls a function with the position in the underlying data of every
+// coordinate in the tensor, in row-major order.
+func (t *Tensor) each(f func(off int)) {
+	n := t.Size()
+	if n == 0 {
+		return
+	}
+
+	idx := make([]int, len(t.shape))
+	off := t.offset
+
+	for range n {
+		f(off)
+
+		// increment the index like an odometer, starting from the last axis
+		for k := len(idx) - 1; k >= 0; k-- {
+			idx[k]++
+			off += t.strides[k]
+			if idx[k] < t.shape[k] {
+				break
+			}
+
+			off -= idx[k] * t.strides[k]
+			idx[k] = 0
+		}
+	}
+}
+
+// Data returns a copy of the coordinates of the tensor in row-major order.
+func (t *Tensor) Data() []float64 {
+	data := make([]float64, 0, t.Size())
+	t.each(func(off int) {
+		data = append(data, t.data[off])
+	})
+
+	return data
+}
+
+// Copy creates and returns a contiguous copy of the tensor, which does not
+// share data with it.
+func (t *Tensor) Copy() *Tensor {
+	c, _ := NewTensor(t.shape, t.Data()...)
+	return c
+}
+
+// isContiguous checks if the tensor is stored in row-major order without gaps.
+func (t *Tensor) isContiguous() bool {
+	s := 1
+	for i := len(t.shape) - 1; i >= 0; i-- {
+		if t.shape[i] != 1 && t.strides[i] != s {
+			return false
+		}
+
+		s *= t.shape[i]
+	}
+
+	return true
+}
+
+// Reshape returns a tensor with the same coordinates as t, but with a given
+// shape. One length of the shape may be -1, in which case it is inferred from
+// the size of the tensor. If t is contiguous, the result is a view sharing the
+// data of t, otherwise the data is copied.
+func (t *Tensor) Reshape(shape ...int) (*Tensor, error) {
+	shape = append([]int(nil), shape...)
+	infer := -1
+	n := 1
+
+	for k, d := range shape {
+		switch {
+		case d == -1 && infer == -1:
+			infer = k
+		case d < 0:
+			return nil, ErrNegativeShape
+		default:
+			n *= d
+		}
+	}
+
+	if infer >= 0 {
+		if n == 0 || t.Size()%n != 0 {
+			return nil, ErrDataMismatch
+		}
+
+		shape[infer] = t.Size() / n
+		n *= shape[infer]
+	}
+
+	if n != t.Size() {
+		return nil, ErrDataMismatch
+	}
+
+	src := t
+	if !t.isContiguous() {
+		src = t.Copy()
+	}
+
+	return &Tensor{
+		data:    src.data,
+		shape:   shape,
+		strides: rowMajorStrides(shape),
+		offset:  src.offset,
+	}, nil
+}
+
+// Transpose returns a view of the tensor with its axes permuted, such that
+// axis k of the result is axis axes[k] of t. If no axes are given, the order of
+// the axes is reversed, which for a 2-dimensional tensor is the usual matrix
+// transpose.
+func (t *Tensor) Transpose(axes ...int) (*Tensor, error) {
+	n := len(t.shape)
+	if len(axes) == 0 {
+		for k := n - 1; k >= 0; k-- {
+			axes = append(axes, k)
+		}
+	}
+
+	if len(axes) != n {
+		return nil, ErrInvalidPermutation
+	}
+
+	seen := make([]bool, n)
+	shape := make([]int, n)
+	strides := make([]int, n)
+
+	for k, a := range axes {
+		if a < 0 || a >= n || seen[a] {
+			return nil, ErrInvalidPermutation
+		}
+
+		seen[a] = true
+		shape[k] = t.shape[a]
+		strides[k] = t.strides[a]
+	}
+
+	return &Tensor{
+		data:    t.data,
+		shape:   shape,
+		strides: strides,
+		offset:  t.offset,
+	}, nil
+}
+
+// Slice returns a view of the tensor restricted to a given range along each
+// axis. If fewer ranges than axes are given, the remaining axes are kept whole.
+// The step of a range must be positive.
+func (t *Tensor) Slice(ranges ...Range) (*Tensor, error) {
+	if len(ranges) > len(t.shape) {
+		return nil, ErrAxisOutOfRange
+	}
+
+	s := &Tensor{
+		data:    t.data,
+		shape:   t.Shape(),
+		strides: append([]int(nil), t.strides...),
+		offset:  t.offset,
+	}
+
+	for k, r := range ranges {
+		d := t.shape[k]
+		start, end, step := r.Start, r.End, r.Step
+
+		if step == 0 {
+			step = 1
+		}
+
+		if end == 0 {
+			end = d
+		}
+
+		if start < 0 {
+			start += d
+		}
+
+		if end < 0 {
+			end += d
+		}
+
+		if step < 0 || start < 0 || end > d || start > end {
+			return nil, ErrInvalidRange
+		}
+
+		s.offset += start * t.strides[k]
+		s.shape[k] = (end - start + step - 1) / step
+		s.strides[k] *= step
+	}
+
+	return s, nil
+}
+
+// Vector returns the coordinates of a 1-dimensional tensor as a vector.
+func (t *Tensor) Vector() (Vector, error) {
+	if len(t.shape) != 1 {
+		return nil, ErrInvalidRank
+	}
+
+	return Vector(t.Data()), nil
+}
+
+// Matrix returns the coordinates of a 2-dimensional tensor as a matrix.
+func (t *Tensor) Matrix() (Matrix, error) {
+	if len(t.shape) != 2 {
+		return nil, ErrInvalidRank
+	}
+
+	data := t.Data()
+	rows, cols := t.shape[0], t.shape[1]
+
+	var m Matrix
+	for i := range rows {
+		m = append(m, NewVector(uint(cols), data[i*cols:(i+1)*cols]...))
+	}
+
+	return m, nil
+}
+
+// broadcastShape returns the shape resulting from broadcasting two shapes
+// together. The shapes are aligned by their last axes, and each pair of lengths
+// must either be equal or one of them must be 1. Missing leading axes are
+// treated as having length 1.
+func broadcastShape(a, b []int) ([]int, error) {
+	n := max(len(a), len(b))
+	shape := make([]int, n)
+
+	for k := range n {
+		da, db := 1, 1
+		if i := len(a) - n + k; i >= 0 {
+			da = a[i]
+		}
+
+		if i := len(b) - n + k; i >= 0 {
+			db = b[i]
+		}
+
+		switch {
+		case da == db || db == 1:
+			shape[k] = da
+		case da == 1:
+			shape[k] = db
+		default:
+			return nil, ErrNotBroadcastable
+		}
+	}
+
+	return shape, nil
+}
+
+// broadcastTo returns a view of the tensor expanded to a given shape, by
+// setting the stride of every broadcast axis to 0. The shape must be the
+// result of broadcastShape.
+func (t *Tensor) broadcastTo(shape []int) *Tensor {
+	n := len(shape)
+	strides := make([]int, n)
+
+	for k := range n {
+		i := len(t.shape) - n + k
+		if i >= 0 && t.shape[i] == shape[k] {
+			strides[k] = t.strides[i]
+		}
+	}
+
+	return &Tensor{
+		data:    t.data,
+		shape:   shape,
+		strides: strides,
+		offset:  t.offset,
+	}
+}
+
+// Apply creates and returns a new tensor with a given function applied to each
+// coordinate of t.
+func (t *Tensor) Apply(f func(float64) float64) *Tensor {
+	data := t.Data()
+	for i := range data {
+		data[i] = f(data[i])
+	}
+
+	r, _ := NewTensor(t.shape, data...)
+	return r
+}
+
+// Combine creates and returns a new tensor by applying a given function to each
+// pair of coordinates of t and u, after broadcasting them to a common shape. If
+// the shapes cannot be broadcast together, an error is returned.
+func (t *Tensor) Combine(u *Tensor, f func(a, b float64) float64) (*Tensor, error) {
+	shape, err := broadcastShape(t.shape, u.shape)
+	if err != nil {
+		return nil, err
+	}
+
+	a := t.broadcastTo(shape).Data()
+	b := u.broadcastTo(shape).Data()
+	for i := range a {
+		a[i] = f(a[i], b[i])
+	}
+
+	return NewTensor(shape, a...)
+}
+
+// Add creates and returns the element-wise sum of t and u, broadcast to a
+// common shape.
+func (t *Tensor) Add(u *Tensor) (*Tensor, error) {
+	return t.Combine(u, func(a, b float64) float64 { return a + b })
+}
+
+// Sub creates and returns the element-wise difference of t and u, broadcast to
+// a common shape.
+func (t *Tensor) Sub(u *Tensor) (*Tensor, error) {
+	return t.Combine(u, func(a, b float64) float64 { return a - b })
+}
+
+// Mul creates and returns the element-wise product of t and u, broadcast to a
+// common shape.
+func (t *Tensor) Mul(u *Tensor) (*Tensor, error) {
+	return t.Combine(u, func(a, b float64) float64 { return a * b })
+}
+
+// Div creates and returns the element-wise quotient of t and u, broadcast to a
+// common shape. Unlike Vector.Div, division by 0 follows IEEE 754 and yields an
+// infinity or NaN.
+func (t *Tensor) Div(u *Tensor) (*Tensor, error) {
+	return t.Combine(u, func(a, b float64) float64 { return a / b })
+}
+
+// Scale creates and returns a new tensor with each coordinate of t multiplied by
+// a given scalar value.
+func (t *Tensor) Scale(scalar float64) *Tensor {
+	return t.Apply(func(a float64) float64 { return a * scalar })
+}
+
+// Reduce reduces the tensor along a given axis, by folding the coordinates
+// along the axis with a given function, starting from init. The result has one
+// axis less than t.
+func (t *Tensor) Reduce(axis int, init float64, f func(acc, a float64) float64) (*Tensor, error) {
+	if axis < 0 || axis >= len(t.shape) {
+		return nil, ErrAxisOutOfRange
+	}
+
+	// move the reduced axis last, so that the coordinates along it are
+	// consecutive in row-major order
+	var axes []int
+	for k := range t.shape {
+		if k != axis {
+			axes = append(axes, k)
+		}
+	}
+	axes = append(axes, axis)
+
+	p, _ := t.Transpose(axes...)
+	data := p.Data()
+	d := t.shape[axis]
+
+	shape := p.shape[:len(p.shape)-1]
+	r, _ := NewTensor(shape)
+
+	for i := range r.data {
+		acc := init
+		for _, a := range data[i*d : (i+1)*d] {
+			acc = f(acc, a)
+		}
+
+		r.data[i] = acc
+	}
+
+	return r, nil
+}
+
+// SumAxis returns the sum of the coordinates along a given axis.
+func (t *Tensor) SumAxis(axis int) (*Tensor, error) {
+	return t.Reduce(axis, 0, func(acc, a float64) float64 { return acc + a })
+}
+
+// MaxAxis returns the maximum of the coordinates along a given axis.
+func (t *Tensor) MaxAxis(axis int) (*Tensor, error) {
+	return t.Reduce(axis, math.Inf(-1), math.Max)
+}
+
+// MeanAxis returns the mean of the coordinates along a given axis.
+func (t *Tensor) MeanAxis(axis int) (*Tensor, error) {
+	s, err := t.SumAxis(axis)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Scale(1 / float64(t.shape[axis])), nil
+}
+
+// Sum returns the sum of all coordinates of the tensor.
+func (t *Tensor) Sum() float64 {
+	var s float64
+	t.each(func(off int) {
+		s += t.data[off]
+	})
+
+	return s
+}
+
+// Max returns the maximum of all coordinates of the tensor. An empty tensor
+// has a maximum of -Inf.
+func (t *Tensor) Max() float64 {
+	m := math.Inf(-1)
+	t.each(func(off int) {
+		m = math.Max(m, t.data[off])
+	})
+
+	return m
+}
+
+// Mean returns the mean of all coordinates of the tensor. An empty tensor has a
+// mean of NaN.
+func (t *Tensor) Mean() float64 {
+	return t.Sum() / float64(t.Size())
+}
@@ -0,0 +1,282 @@
+package algebraic_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// arange returns a new tensor with a given shape holding 0, 1, 2, ... in
+// row-major order.
+func arange(t *testing.T, shape ...int) *algebraic.Tensor {
+	n := 1
+	for _, d := range shape {
+		n *= d
+	}
+
+	cs := make([]float64, n)
+	for i := range cs {
+		cs[i] = float64(i)
+	}
+
+	ts, err := algebraic.NewTensor(shape, cs...)
+	assert.NoError(t, err)
+	return ts
+}
+
+func TestNewTensor(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		shape   []int
+		coords  []float64
+		want    []float64
+		wantErr error
+	}{
+		"should return a new 2x3 tensor with set values": {
+			shape:  []int{2, 3},
+			coords: []float64{1, 2, 3, 4, 5, 6},
+			want:   []float64{1, 2, 3, 4, 5, 6},
+		},
+		"should return a new zero-filled 2x2 tensor": {
+			shape: []int{2, 2},
+			want:  []float64{0, 0, 0, 0},
+		},
+		"should return an error given too few coordinates": {
+			shape:   []int{2, 2},
+			coords:  []float64{1, 2, 3},
+			wantErr: algebraic.ErrDataMismatch,
+		},
+		"should return an error given a negative shape": {
+			shape:   []int{2, -1},
+			wantErr: algebraic.ErrNegativeShape,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := algebraic.NewTensor(test.shape, test.coords...)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.Equal(test.shape, got.Shape())
+				assert.Equal(test.want, got.Data())
+			}
+		})
+	}
+}
+
+func TestTensorAt(t *testing.T) {
+	assert := assert.New(t)
+	ts := arange(t, 2, 3, 4)
+
+	got, err := ts.At(1, 2, 3)
+	assert.NoError(err)
+	assert.Equal(23.0, got)
+
+	assert.NoError(ts.Set(-1, 0, 1, 2))
+	got, _ = ts.At(0, 1, 2)
+	assert.Equal(-1.0, got)
+
+	_, err = ts.At(2, 0, 0)
+	assert.ErrorIs(err, algebraic.ErrIndexOutOfRange)
+
+	_, err = ts.At(0, 0)
+	assert.ErrorIs(err, algebraic.ErrIndexOutOfRange)
+}
+
+func TestTensorReshape(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		shape     []int
+		wantShape []int
+		wantErr   error
+	}{
+		"should reshape a 2x3x4 tensor to 6x4": {
+			shape:     []int{6, 4},
+			wantShape: []int{6, 4},
+		},
+		"should infer a length of -1": {
+			shape:     []int{4, -1},
+			wantShape: []int{4, 6},
+		},
+		"should return an error given a mismatching size": {
+			shape:   []int{5, 5},
+			wantErr: algebraic.ErrDataMismatch,
+		},
+		"should return an error given a length that cannot be inferred": {
+			shape:   []int{5, -1},
+			wantErr: algebraic.ErrDataMismatch,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ts := arange(t, 2, 3, 4)
+			got, err := ts.Reshape(test.shape...)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.Equal(test.wantShape, got.Shape())
+				assert.Equal(ts.Data(), got.Data())
+			}
+		})
+	}
+}
+
+func TestTensorTranspose(t *testing.T) {
+	assert := assert.New(t)
+	ts := arange(t, 2, 3)
+
+	got, err := ts.Transpose()
+	assert.NoError(err)
+	assert.Equal([]int{3, 2}, got.Shape())
+	assert.Equal([]float64{0, 3, 1, 4, 2, 5}, got.Data())
+
+	// a transposed tensor is a non-contiguous view, so reshaping copies
+	r, err := got.Reshape(6)
+	assert.NoError(err)
+	assert.Equal([]float64{0, 3, 1, 4, 2, 5}, r.Data())
+
+	p, err := arange(t, 2, 3, 4).Transpose(2, 0, 1)
+	assert.NoError(err)
+	assert.Equal([]int{4, 2, 3}, p.Shape())
+	c, _ := p.At(3, 1, 2)
+	assert.Equal(23.0, c)
+
+	_, err = ts.Transpose(0, 0)
+	assert.ErrorIs(err, algebraic.ErrInvalidPermutation)
+}
+
+func TestTensorSlice(t *testing.T) {
+	assert := assert.New(t)
+	ts := arange(t, 4, 5)
+
+	got, err := ts.Slice(algebraic.Range{Start: 1, End: 3}, algebraic.Range{Start: 0, End: 5, Step: 2})
+	assert.NoError(err)
+	assert.Equal([]int{2, 3}, got.Shape())
+	assert.Equal([]float64{5, 7, 9, 10, 12, 14}, got.Data())
+
+	// a slice shares data with the tensor
+	assert.NoError(got.Set(-1, 0, 0))
+	c, _ := ts.At(1, 0)
+	assert.Equal(-1.0, c)
+
+	got, err = ts.Slice(algebraic.Range{Start: -1})
+	assert.NoError(err)
+	assert.Equal([]float64{15, 16, 17, 18, 19}, got.Data())
+
+	_, err = ts.Slice(algebraic.Range{Start: 3, End: 2})
+	assert.ErrorIs(err, algebraic.ErrInvalidRange)
+}
+
+func TestTensorBroadcast(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		a, b      *algebraic.Tensor
+		wantShape []int
+		want      []float64
+		wantErr   error
+	}{
+		"should add tensors of equal shape": {
+			a:         arange(t, 2, 2),
+			b:         arange(t, 2, 2),
+			wantShape: []int{2, 2},
+			want:      []float64{0, 2, 4, 6},
+		},
+		"should add a row to each row of a matrix": {
+			a:         arange(t, 2, 3),
+			b:         arange(t, 3),
+			wantShape: []int{2, 3},
+			want:      []float64{0, 2, 4, 3, 5, 7},
+		},
+		"should add a column to a row": {
+			a:         arange(t, 2, 1),
+			b:         arange(t, 1, 3),
+			wantShape: []int{2, 3},
+			want:      []float64{0, 1, 2, 1, 2, 3},
+		},
+		"should return an error given incompatible shapes": {
+			a:       arange(t, 2, 3),
+			b:       arange(t, 2),
+			wantErr: algebraic.ErrNotBroadcastable,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := test.a.Add(test.b)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.Equal(test.wantShape, got.Shape())
+				assert.Equal(test.want, got.Data())
+			}
+		})
+	}
+}
+
+func TestTensorReductions(t *testing.T) {
+	assert := assert.New(t)
+	ts := arange(t, 2, 3)
+
+	s, err := ts.SumAxis(0)
+	assert.NoError(err)
+	assert.Equal([]int{3}, s.Shape())
+	assert.Equal([]float64{3, 5, 7}, s.Data())
+
+	s, err = ts.SumAxis(1)
+	assert.NoError(err)
+	assert.Equal([]float64{3, 12}, s.Data())
+
+	m, err := ts.MaxAxis(0)
+	assert.NoError(err)
+	assert.Equal([]float64{3, 4, 5}, m.Data())
+
+	mean, err := ts.MeanAxis(1)
+	assert.NoError(err)
+	assert.Equal([]float64{1, 4}, mean.Data())
+
+	assert.Equal(15.0, ts.Sum())
+	assert.Equal(5.0, ts.Max())
+	assert.Equal(2.5, ts.Mean())
+
+	_, err = ts.SumAxis(2)
+	assert.ErrorIs(err, algebraic.ErrAxisOutOfRange)
+}
+
+func TestTensorConversion(t *testing.T) {
+	assert := assert.New(t)
+	m := algebraic.NewMatrix(2, 3,
+		1, 2, 3,
+		4, 5, 6,
+	)
+
+	ts, err := algebraic.NewTensorFromMatrix(m)
+	assert.NoError(err)
+	assert.Equal([]int{2, 3}, ts.Shape())
+
+	tt, _ := ts.Transpose()
+	got, err := tt.Matrix()
+	assert.NoError(err)
+	assert.EqualValues(algebraic.NewMatrix(3, 2, 1, 4, 2, 5, 3, 6), got)
+
+	_, err = ts.Vector()
+	assert.ErrorIs(err, algebraic.ErrInvalidRank)
+
+	v := algebraic.NewVector(3, 1, 2, 3)
+	vt := algebraic.NewTensorFromVector(v)
+	sum, err := ts.Add(vt)
+	assert.NoError(err)
+	assert.Equal([]float64{2, 4, 6, 5, 7, 9}, sum.Data())
+
+	row, _ := sum.Slice(algebraic.Range{Start: 1, End: 2})
+	row, _ = row.Reshape(-1)
+	gv, err := row.Vector()
+	assert.NoError(err)
+	assert.EqualValues(algebraic.NewVector(3, 5, 7, 9), gv)
+}
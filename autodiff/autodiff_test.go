@@ -0,0 +1,147 @@
+package autodiff_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/autodiff"
+)
+
+// rosenbrock returns (1-x₀)² + 100·(x₁-x₀²)².
+func rosenbrock[T autodiff.Scalar[T]](x []T) T {
+	a := x[0].Const(1).Sub(x[0])
+	b := x[1].Sub(x[0].Mul(x[0]))
+	return a.Mul(a).Add(b.Mul(b).Scale(100))
+}
+
+// mixed returns exp(x₀)·sin(x₁) + log(x₂)/sqrt(x₀) - tanh(x₁)·cos(x₂)·x₂³.
+func mixed[T autodiff.Scalar[T]](x []T) T {
+	a := x[0].Exp().Mul(x[1].Sin())
+	b := x[2].Log().Div(x[0].Sqrt())
+	c := x[1].Tanh().Mul(x[2].Cos()).Mul(x[2].Pow(3))
+	return a.Add(b).Sub(c)
+}
+
+// polar returns the cartesian coordinates (r·cos(θ), r·sin(θ)).
+func polar[T autodiff.Scalar[T]](x []T) []T {
+	return []T{x[0].Mul(x[1].Cos()), x[0].Mul(x[1].Sin())}
+}
+
+func mixedGrad(x algebraic.Vector) algebraic.Vector {
+	x0, x1, x2 := x[0], x[1], x[2]
+	th := math.Tanh(x1)
+	return algebraic.NewVector(3,
+		math.Exp(x0)*math.Sin(x1)-0.5*math.Log(x2)*math.Pow(x0, -1.5),
+		math.Exp(x0)*math.Cos(x1)-(1-th*th)*math.Cos(x2)*math.Pow(x2, 3),
+		1/(x2*math.Sqrt(x0))-th*(3*x2*x2*math.Cos(x2)-math.Pow(x2, 3)*math.Sin(x2)),
+	)
+}
+
+func TestGradient(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		forward  func([]autodiff.Dual) autodiff.Dual
+		reverse  func([]autodiff.Var) autodiff.Var
+		x        algebraic.Vector
+		wantVal  float64
+		wantGrad algebraic.Vector
+	}{
+		"should differentiate the rosenbrock function": {
+			forward:  rosenbrock[autodiff.Dual],
+			reverse:  rosenbrock[autodiff.Var],
+			x:        algebraic.NewVector(2, -1.2, 1),
+			wantVal:  24.2,
+			wantGrad: algebraic.NewVector(2, -215.6, -88),
+		},
+		"should differentiate a function of all operations": {
+			forward:  mixed[autodiff.Dual],
+			reverse:  mixed[autodiff.Var],
+			x:        algebraic.NewVector(3, 0.7, 1.3, 2.1),
+			wantVal:  mixed([]autodiff.Dual{{Val: 0.7}, {Val: 1.3}, {Val: 2.1}}).Val,
+			wantGrad: mixedGrad(algebraic.NewVector(3, 0.7, 1.3, 2.1)),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			val, grad := autodiff.Gradient(test.forward, test.x)
+			assert.InDelta(test.wantVal, val, 1e-12)
+			assert.InDeltaSlice(test.wantGrad, grad, 1e-9)
+
+			val, grad = autodiff.ReverseGradient(test.reverse, test.x)
+			assert.InDelta(test.wantVal, val, 1e-12)
+			assert.InDeltaSlice(test.wantGrad, grad, 1e-9)
+		})
+	}
+}
+
+func TestJacobian(t *testing.T) {
+	assert := assert.New(t)
+	r, th := 2.0, math.Pi/6
+	x := algebraic.NewVector(2, r, th)
+	want := algebraic.NewMatrix(2, 2,
+		math.Cos(th), -r*math.Sin(th),
+		math.Sin(th), r*math.Cos(th),
+	)
+
+	for name, got := range map[string]algebraic.Matrix{
+		"forward": autodiff.Jacobian(polar[autodiff.Dual], x),
+		"reverse": autodiff.ReverseJacobian(polar[autodiff.Var], x),
+	} {
+		t.Run("should compute the jacobian in "+name+" mode", func(t *testing.T) {
+			assert.Len(got, 2)
+			for i := range want {
+				assert.InDeltaSlice(want[i], got[i], 1e-12)
+			}
+		})
+	}
+}
+
+func TestHessian(t *testing.T) {
+	assert := assert.New(t)
+	x := algebraic.NewVector(2, -1.2, 1)
+	want := algebraic.NewMatrix(2, 2,
+		2-400*x[1]+1200*x[0]*x[0], -400*x[0],
+		-400*x[0], 200,
+	)
+
+	got := autodiff.Hessian(rosenbrock[autodiff.HyperDual], x)
+	for i := range want {
+		assert.InDeltaSlice(want[i], got[i], 1e-9)
+	}
+
+	// compare against a finite difference of the exact gradient
+	h := 1e-6
+	y := algebraic.NewVector(3, 0.7, 1.3, 2.1)
+	hm := autodiff.Hessian(mixed[autodiff.HyperDual], y)
+	for j := range y {
+		yp := algebraic.NewVector(3, y...)
+		ym := algebraic.NewVector(3, y...)
+		yp[j] += h
+		ym[j] -= h
+		gp, gm := mixedGrad(yp), mixedGrad(ym)
+		for i := range y {
+			assert.InDelta((gp[i]-gm[i])/(2*h), hm[i][j], 1e-6)
+		}
+	}
+}
+
+func TestTape(t *testing.T) {
+	assert := assert.New(t)
+	tape := autodiff.NewTape()
+	x := tape.NewVar(3)
+	y := tape.NewVar(4)
+	z := tape.NewVar(5)
+
+	// f = x·y + 2, which does not depend on z
+	f := x.Mul(y).Add(x.Const(2))
+	assert.Equal(14.0, f.Value())
+	assert.Equal(5, tape.Len())
+	assert.EqualValues(algebraic.NewVector(3, 4, 3, 0), tape.Gradient(f, []autodiff.Var{x, y, z}))
+
+	tape.Reset()
+	assert.Equal(0, tape.Len())
+}
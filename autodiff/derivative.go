@@ -0,0 +1,123 @@
+package autodiff
+
+import (
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Gradient returns the value and gradient of a scalar function f at x using
+// forward mode, i.e. by evaluating f once per coordinate of x on dual numbers.
+// Forward mode is efficient for functions of few variables.
+func Gradient(f func([]Dual) Dual, x algebraic.Vector) (float64, algebraic.Vector) {
+	xs := make([]Dual, len(x))
+	for i, c := range x {
+		xs[i] = Dual{Val: c}
+	}
+
+	var val float64
+	g := algebraic.NewZeroVector(x.Dimension())
+
+	for i := range xs {
+		xs[i].Der = 1
+		y := f(xs)
+		xs[i].Der = 0
+
+		val, g[i] = y.Val, y.Der
+	}
+
+	if len(xs) == 0 {
+		val = f(xs).Val
+	}
+
+	return val, g
+}
+
+// ReverseGradient returns the value and gradient of a scalar function f at x
+// using reverse mode, i.e. by evaluating f once on variables recorded on a tape
+// and then propagating derivatives backwards. The cost is independent of the
+// number of variables, which makes reverse mode efficient for functions of
+// many variables.
+func ReverseGradient(f func([]Var) Var, x algebraic.Vector) (float64, algebraic.Vector) {
+	t := NewTape()
+	xs := make([]Var, len(x))
+	for i, c := range x {
+		xs[i] = t.NewVar(c)
+	}
+
+	y := f(xs)
+	return y.val, t.Gradient(y, xs)
+}
+
+// Jacobian returns the Jacobian matrix of a vector function f at x using
+// forward mode, such that the entry at row i and column j is ∂fᵢ/∂xⱼ. One
+// column is computed per evaluation of f.
+func Jacobian(f func([]Dual) []Dual, x algebraic.Vector) algebraic.Matrix {
+	xs := make([]Dual, len(x))
+	for i, c := range x {
+		xs[i] = Dual{Val: c}
+	}
+
+	var jac algebraic.Matrix
+
+	for j := range xs {
+		xs[j].Der = 1
+		ys := f(xs)
+		xs[j].Der = 0
+
+		if jac == nil {
+			jac = algebraic.NewMatrix(uint(len(ys)), x.Dimension())
+		}
+
+		for i, y := range ys {
+			jac[i][j] = y.Der
+		}
+	}
+
+	return jac
+}
+
+// ReverseJacobian returns the Jacobian matrix of a vector function f at x using
+// reverse mode. The function is evaluated once, after which one row is computed
+// per backward pass, which is efficient for functions with fewer outputs than
+// inputs.
+func ReverseJacobian(f func([]Var) []Var, x algebraic.Vector) algebraic.Matrix {
+	t := NewTape()
+	xs := make([]Var, len(x))
+	for i, c := range x {
+		xs[i] = t.NewVar(c)
+	}
+
+	var jac algebraic.Matrix
+	for _, y := range f(xs) {
+		jac = append(jac, t.Gradient(y, xs))
+	}
+
+	return jac
+}
+
+// Hessian returns the Hessian matrix of a scalar function f at x, i.e. the
+// matrix of second partial derivatives, by evaluating f on hyper-dual numbers
+// once for each entry on or above the diagonal. The result is exact up to
+// rounding, and symmetric.
+func Hessian(f func([]HyperDual) HyperDual, x algebraic.Vector) algebraic.Matrix {
+	n := x.Dimension()
+	xs := make([]HyperDual, n)
+	for i, c := range x {
+		xs[i] = HyperDual{Val: c}
+	}
+
+	h := algebraic.NewMatrix(n, n)
+
+	for i := range xs {
+		for j := i; j < len(xs); j++ {
+			xs[i].D1 = 1
+			xs[j].D2 = 1
+			y := f(xs)
+			xs[i].D1 = 0
+			xs[j].D2 = 0
+
+			h[i][j], h[j][i] = y.D12, y.D12
+		}
+	}
+
+	return h
+}
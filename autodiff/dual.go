@@ -0,0 +1,97 @@
+package autodiff
+
+import "math"
+
+// Dual defines a dual number a + b·ε, where ε² = 0. Evaluating a function on
+// dual numbers propagates derivatives alongside values by the chain rule, as
+// f(a + b·ε) = f(a) + f'(a)·b·ε. Seeding the input xᵢ with a derivative of 1
+// and all others with 0 thereby yields the partial derivative ∂f/∂xᵢ. This is
+// forward mode automatic differentiation, which costs a single evaluation per
+// input variable.
+type Dual struct {
+	Val float64
+	Der float64
+}
+
+// Value returns the real part of the dual number.
+func (a Dual) Value() float64 {
+	return a.Val
+}
+
+// Const returns a dual number with a given value and zero derivative.
+func (a Dual) Const(c float64) Dual {
+	return Dual{Val: c}
+}
+
+// Add returns the sum of a and b.
+func (a Dual) Add(b Dual) Dual {
+	return Dual{a.Val + b.Val, a.Der + b.Der}
+}
+
+// Sub returns the difference of a and b.
+func (a Dual) Sub(b Dual) Dual {
+	return Dual{a.Val - b.Val, a.Der - b.Der}
+}
+
+// Mul returns the product of a and b.
+func (a Dual) Mul(b Dual) Dual {
+	return Dual{a.Val * b.Val, a.Der*b.Val + a.Val*b.Der}
+}
+
+// Div returns the quotient of a and b.
+func (a Dual) Div(b Dual) Dual {
+	return Dual{a.Val / b.Val, (a.Der*b.Val - a.Val*b.Der) / (b.Val * b.Val)}
+}
+
+// Neg returns the negation of a.
+func (a Dual) Neg() Dual {
+	return Dual{-a.Val, -a.Der}
+}
+
+// Scale returns a multiplied by a scalar value.
+func (a Dual) Scale(c float64) Dual {
+	return Dual{a.Val * c, a.Der * c}
+}
+
+// chain applies a function with value f and derivative df at a.
+func (a Dual) chain(f, df float64) Dual {
+	return Dual{f, df * a.Der}
+}
+
+// Pow returns a raised to the power of p.
+func (a Dual) Pow(p float64) Dual {
+	return a.chain(math.Pow(a.Val, p), p*math.Pow(a.Val, p-1))
+}
+
+// Sqrt returns the square root of a.
+func (a Dual) Sqrt() Dual {
+	s := math.Sqrt(a.Val)
+	return a.chain(s, 0.5/s)
+}
+
+// Exp returns e raised to the power of a.
+func (a Dual) Exp() Dual {
+	e := math.Exp(a.Val)
+	return a.chain(e, e)
+}
+
+// Log returns the natural logarithm of a.
+func (a Dual) Log() Dual {
+	return a.chain(math.Log(a.Val), 1/a.Val)
+}
+
+// Sin returns the sine of a.
+func (a Dual) Sin() Dual {
+	return a.chain(math.Sin(a.Val), math.Cos(a.Val))
+}
+
+// Cos returns the cosine of a.
+func (a Dual) Cos() Dual {
+	return a.chain(math.Cos(a.Val), -math.Sin(a.Val))
+}
+
+// Tanh returns the hyperbolic tangent of a.
+func (a Dual) Tanh() Dual {
+	t := math.Tanh(a.Val)
+	return a.chain(t, 1-t*t)
+}
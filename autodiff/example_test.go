@@ -0,0 +1,33 @@
+package autodiff_test
+
+import (
+	"fmt"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/autodiff"
+)
+
+// f is written once over Scalar, and computes x₀·x₁ + sin(x₀).
+func f[T autodiff.Scalar[T]](x []T) T {
+	return x[0].Mul(x[1]).Add(x[0].Sin())
+}
+
+func ExampleScalar() {
+	x := algebraic.NewVector(2, 0, 3)
+
+	// forward mode
+	v, g := autodiff.Gradient(f[autodiff.Dual], x)
+	fmt.Println(v, g)
+
+	// reverse mode
+	v, g = autodiff.ReverseGradient(f[autodiff.Var], x)
+	fmt.Println(v, g)
+
+	// second derivatives
+	fmt.Println(autodiff.Hessian(f[autodiff.HyperDual], x))
+	// Output:
+	// 0 [4 0]
+	// 0 [4 0]
+	// |0  1|
+	// |1  0|
+}
@@ -0,0 +1,110 @@
+package autodiff
+
+import "math"
+
+// HyperDual defines a hyper-dual number a + b·ε₁ + c·ε₂ + d·ε₁ε₂, where
+// ε₁² = ε₂² = 0 but ε₁ε₂ ≠ 0. Seeding the input xᵢ with ε₁ and xⱼ with ε₂, the
+// ε₁ε₂ part of the result is the exact second partial derivative ∂²f/∂xᵢ∂xⱼ,
+// without the truncation errors of finite differences.
+type HyperDual struct {
+	Val float64
+	D1  float64
+	D2  float64
+	D12 float64
+}
+
+// Value returns the real part of the hyper-dual number.
+func (a HyperDual) Value() float64 {
+	return a.Val
+}
+
+// Const returns a hyper-dual number with a given value and zero derivatives.
+func (a HyperDual) Const(c float64) HyperDual {
+	return HyperDual{Val: c}
+}
+
+// Add returns the sum of a and b.
+func (a HyperDual) Add(b HyperDual) HyperDual {
+	return HyperDual{a.Val + b.Val, a.D1 + b.D1, a.D2 + b.D2, a.D12 + b.D12}
+}
+
+// Sub returns the difference of a and b.
+func (a HyperDual) Sub(b HyperDual) HyperDual {
+	return HyperDual{a.Val - b.Val, a.D1 - b.D1, a.D2 - b.D2, a.D12 - b.D12}
+}
+
+// Mul returns the product of a and b.
+func (a HyperDual) Mul(b HyperDual) HyperDual {
+	return HyperDual{
+		Val: a.Val * b.Val,
+		D1:  a.D1*b.Val + a.Val*b.D1,
+		D2:  a.D2*b.Val + a.Val*b.D2,
+		D12: a.D12*b.Val + a.D1*b.D2 + a.D2*b.D1 + a.Val*b.D12,
+	}
+}
+
+// Div returns the quotient of a and b.
+func (a HyperDual) Div(b HyperDual) HyperDual {
+	return a.Mul(b.Pow(-1))
+}
+
+// Neg returns the negation of a.
+func (a HyperDual) Neg() HyperDual {
+	return a.Scale(-1)
+}
+
+// Scale returns a multiplied by a scalar value.
+func (a HyperDual) Scale(c float64) HyperDual {
+	return HyperDual{a.Val * c, a.D1 * c, a.D2 * c, a.D12 * c}
+}
+
+// chain applies a function with value f, first derivative df and second
+// derivative ddf at a.
+func (a HyperDual) chain(f, df, ddf float64) HyperDual {
+	return HyperDual{
+		Val: f,
+		D1:  df * a.D1,
+		D2:  df * a.D2,
+		D12: df*a.D12 + ddf*a.D1*a.D2,
+	}
+}
+
+// Pow returns a raised to the power of p.
+func (a HyperDual) Pow(p float64) HyperDual {
+	return a.chain(math.Pow(a.Val, p), p*math.Pow(a.Val, p-1), p*(p-1)*math.Pow(a.Val, p-2))
+}
+
+// Sqrt returns the square root of a.
+func (a HyperDual) Sqrt() HyperDual {
+	s := math.Sqrt(a.Val)
+	return a.chain(s, 0.5/s, -0.25/(s*a.Val))
+}
+
+// Exp returns e raised to the power of a.
+func (a HyperDual) Exp() HyperDual {
+	e := math.Exp(a.Val)
+	return a.chain(e, e, e)
+}
+
+// Log returns the natural logarithm of a.
+func (a HyperDual) Log() HyperDual {
+	return a.chain(math.Log(a.Val), 1/a.Val, -1/(a.Val*a.Val))
+}
+
+// Sin returns the sine of a.
+func (a HyperDual) Sin() HyperDual {
+	s := math.Sin(a.Val)
+	return a.chain(s, math.Cos(a.Val), -s)
+}
+
+// Cos returns the cosine of a.
+func (a HyperDual) Cos() HyperDual {
+	c := math.Cos(a.Val)
+	return a.chain(c, -math.Sin(a.Val), -c)
+}
+
+// Tanh returns the hyperbolic tangent of a.
+func (a HyperDual) Tanh() HyperDual {
+	t := math.Tanh(a.Val)
+	return a.chain(t, 1-t*t, -2*t*(1-t*t))
+}
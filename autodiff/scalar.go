@@ -0,0 +1,35 @@
+package autodiff
+
+// Scalar defines the operations available to functions that are to be
+// differentiated. Since Go has no operator overloading, a function is written
+// once as a generic function over Scalar, and then instantiated with Dual for
+// forward mode, Var for reverse mode, or HyperDual for second derivatives:
+//
+//	func f[T autodiff.Scalar[T]](x []T) T {
+//		// x₀·x₁ + sin(x₀)
+//		return x[0].Mul(x[1]).Add(x[0].Sin())
+//	}
+//
+//	_, g := autodiff.Gradient(f[autodiff.Dual], x)
+//
+// Const creates a constant of the same kind as the receiver, so that constants
+// can be mixed with variables, e.g. x[0].Const(2).Mul(x[1]).
+type Scalar[T any] interface {
+	Value() float64
+	Const(c float64) T
+
+	Add(T) T
+	Sub(T) T
+	Mul(T) T
+	Div(T) T
+	Neg() T
+	Scale(c float64) T
+
+	Pow(p float64) T
+	Sqrt() T
+	Exp() T
+	Log() T
+	Sin() T
+	Cos() T
+	Tanh() T
+}
@@ -0,0 +1,203 @@
+package autodiff
+
+import (
+	"math"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Tape records the operations performed on variables during the evaluation of
+// a function, as a list of nodes in evaluation order. Each node holds the
+// indices of up to two operands, along with the partial derivatives of the
+// operation with respect to them. Once the function is evaluated, a single
+// backward pass through the tape accumulates the derivative of the output with
+// respect to every node by the chain rule. This is reverse mode automatic
+// differentiation, which costs a single evaluation and backward pass per
+// output, regardless of the number of inputs.
+type Tape struct {
+	nodes []node
+}
+
+// node defines an operation recorded on the tape. A parent index of -1 means
+// the operand is absent or constant.
+type node struct {
+	parents  [2]int
+	partials [2]float64
+}
+
+// NewTape creates a new instance of an empty tape.
+func NewTape() *Tape {
+	return &Tape{}
+}
+
+// Var defines a variable recorded on a tape, along with its value. A Var
+// without a tape is a constant.
+type Var struct {
+	tape *Tape
+	idx  int
+	val  float64
+}
+
+// NewVar creates a new independent variable with a given value on the tape.
+func (t *Tape) NewVar(val float64) Var {
+	t.nodes = append(t.nodes, node{parents: [2]int{-1, -1}})
+	return Var{tape: t, idx: len(t.nodes) - 1, val: val}
+}
+
+// Len returns the number of nodes recorded on the tape.
+func (t *Tape) Len() int {
+	return len(t.nodes)
+}
+
+// Reset clears the tape, so it can be reused for a new evaluation. Variables
+// recorded before the reset must not be used afterwards.
+func (t *Tape) Reset() {
+	t.nodes = t.nodes[:0]
+}
+
+// Gradient returns the derivatives of the output y with respect to the given
+// variables, by a backward pass through the tape from y. A variable which y
+// does not depend on has a derivative of 0.
+func (t *Tape) Gradient(y Var, xs []Var) algebraic.Vector {
+	g := algebraic.NewZeroVector(uint(len(xs)))
+	if y.tape != t {
+		return g
+	}
+
+	adj := make([]float64, y.idx+1)
+	adj[y.idx] = 1
+
+	for i := y.idx; i >= 0; i-- {
+		if adj[i] == 0 {
+			continue
+		}
+
+		n := t.nodes[i]
+		for k, p := range n.parents {
+			if p >= 0 {
+				adj[p] += n.partials[k] * adj[i]
+			}
+		}
+	}
+
+	for k, x := range xs {
+		if x.tape == t && x.idx <= y.idx {
+			g[k] = adj[x.idx]
+		}
+	}
+
+	return g
+}
+
+// record adds a node for an operation with the given operands and partial
+// derivatives to the tape of the operands, and returns the resulting variable.
+// Constant operands are left out. Operands must not be recorded on different
+// tapes.
+func record(val float64, a Var, da float64, b Var, db float64) Var {
+	t := a.tape
+	if t == nil {
+		t = b.tape
+	} else if b.tape != nil && b.tape != t {
+		panic("autodiff: variables are recorded on different tapes")
+	}
+
+	if t == nil {
+		return Var{idx: -1, val: val}
+	}
+
+	n := node{parents: [2]int{-1, -1}}
+	if a.tape != nil {
+		n.parents[0], n.partials[0] = a.idx, da
+	}
+
+	if b.tape != nil {
+		n.parents[1], n.partials[1] = b.idx, db
+	}
+
+	t.nodes = append(t.nodes, n)
+	return Var{tape: t, idx: len(t.nodes) - 1, val: val}
+}
+
+// unary records a single operand operation with value f and derivative df.
+func (a Var) unary(f, df float64) Var {
+	return record(f, a, df, Var{idx: -1}, 0)
+}
+
+// Value returns the value of the variable.
+func (a Var) Value() float64 {
+	return a.val
+}
+
+// Const returns a constant with a given value, which is not recorded on any
+// tape.
+func (a Var) Const(c float64) Var {
+	return Var{idx: -1, val: c}
+}
+
+// Add returns the sum of a and b.
+func (a Var) Add(b Var) Var {
+	return record(a.val+b.val, a, 1, b, 1)
+}
+
+// Sub returns the difference of a and b.
+func (a Var) Sub(b Var) Var {
+	return record(a.val-b.val, a, 1, b, -1)
+}
+
+// Mul returns the product of a and b.
+func (a Var) Mul(b Var) Var {
+	return record(a.val*b.val, a, b.val, b, a.val)
+}
+
+// Div returns the quotient of a and b.
+func (a Var) Div(b Var) Var {
+	return record(a.val/b.val, a, 1/b.val, b, -a.val/(b.val*b.val))
+}
+
+// Neg returns the negation of a.
+func (a Var) Neg() Var {
+	return a.unary(-a.val, -1)
+}
+
+// Scale returns a multiplied by a scalar value.
+func (a Var) Scale(c float64) Var {
+	return a.unary(a.val*c, c)
+}
+
+// Pow returns a raised to the power of p.
+func (a Var) Pow(p float64) Var {
+	return a.unary(math.Pow(a.val, p), p*math.Pow(a.val, p-1))
+}
+
+// Sqrt returns the square root of a.
+func (a Var) Sqrt() Var {
+	s := math.Sqrt(a.val)
+	return a.unary(s, 0.5/s)
+}
+
+// Exp returns e raised to the power of a.
+func (a Var) Exp() Var {
+	e := math.Exp(a.val)
+	return a.unary(e, e)
+}
+
+// Log returns the natural logarithm of a.
+func (a Var) Log() Var {
+	return a.unary(math.Log(a.val), 1/a.val)
+}
+
+// Sin returns the sine of a.
+func (a Var) Sin() Var {
+	return a.unary(math.Sin(a.val), math.Cos(a.val))
+}
+
+// Cos returns the cosine of a.
+func (a Var) Cos() Var {
+	return a.unary(math.Cos(a.val), -math.Sin(a.val))
+}
+
+// Tanh returns the hyperbolic tangent of a.
+func (a Var) Tanh() Var {
+	t := math.Tanh(a.val)
+	return a.unary(t, 1-t*t)
+}
@@ -0,0 +1,281 @@
+package elementary
+
+// NewBinomialHeap creates a new instance of a binomial heap, ordered by a less
+// function. A binomial heap is a collection of binomial trees, where the
+// binomial tree Bₖ consists of two Bₖ₋₁ trees linked together, such that the
+// root of one is the leftmost child of the root of the other. Bₖ has 2ᵏ nodes
+// and height k, and its root has degree k. Each tree satisfies the heap
+// property, i.e. no node is less than its parent, and the heap contains at
+// most one tree of each degree, in increasing order. A heap of n elements thus
+// consists of at most ⌊lg n⌋+1 trees, one per set bit in the binary
+// representation of n:
+//
+//	B₀     B₁      B₂
+//	○      ○       ○
+//	       |      /|
+//	       ○     ○ ○
+//	             |
+//	             ○
+//
+// Two heaps are melded much like two binary numbers are added, by linking
+// trees of equal degree into a tree of one degree higher, which carries over
+// to the next degree. Insert, Meld, Pop and DecreaseKey are done in O(lg n)
+// time, as is Peek, which scans the roots.
+func NewBinomialHeap[T any](less func(a, b T) bool) *BinomialHeap[T] {
+	return &BinomialHeap[T]{
+		less:  less,
+		owner: &heapOwner{},
+	}
+}
+
+// BinomialHeap defines a binomial heap structure with a list of roots, an
+// ordering function, a count of the elements in it and the owner of its
+// elements.
+type BinomialHeap[T any] struct {
+	head  *binomialNode[T]
+	less  func(a, b T) bool
+	count int
+	owner *heapOwner
+}
+
+// BinomialElement defines an element of the binomial heap. As values move
+// between the nodes of the heap when a key is decreased, the element keeps
+// track of the node currently holding it, and serves as a stable handle to the
+// value. It also points to its owner, which identifies the heap holding it.
+type BinomialElement[T any] struct {
+	node  *binomialNode[T]
+	owner *heapOwner
+	value T
+}
+
+// Value returns the value of the element.
+func (e *BinomialElement[T]) Value() T {
+	return e.value
+}
+
+// binomialNode defines a node of a binomial tree, with a pointer to its
+// parent, its leftmost child and its right sibling.
+type binomialNode[T any] struct {
+	parent  *binomialNode[T]
+	child   *binomialNode[T]
+	sibling *binomialNode[T]
+	degree  int
+	elem    *BinomialElement[T]
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *BinomialHeap[T]) IsEmpty() bool {
+	return h.head == nil
+}
+
+// Size returns the total number of elements in the heap.
+func (h *BinomialHeap[T]) Size() int {
+	return h.count
+}
+
+// Insert adds a value to the heap, by melding it with a heap holding only the
+// value, and returns the element holding it.
+func (h *BinomialHeap[T]) Insert(val T) *BinomialElement[T] {
+	e := &BinomialElement[T]{
+		value: val,
+		owner: h.owner,
+	}
+
+	e.node = &binomialNode[T]{
+		elem: e,
+	}
+
+	h.head = h.union(h.head, e.node)
+	h.count++
+
+	return e
+}
+
+// Push adds a value to the heap.
+func (h *BinomialHeap[T]) Push(val T) {
+	h.Insert(val)
+}
+
+// Peek returns the top value of the heap, unless the heap is empty. The value
+// is not removed.
+func (h *BinomialHeap[T]) Peek() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	_, x := h.top()
+	return x.elem.value, nil
+}
+
+// Pop removes and returns the top value of the heap, unless the heap
+// underflows. The root holding the top value is removed from the list of
+// roots, and its children, which form a binomial heap of their own in reverse
+// order, are melded back into the heap.
+func (h *BinomialHeap[T]) Pop() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	prev, x := h.top()
+	if prev == nil {
+		h.head = x.sibling
+	} else {
+		prev.sibling = x.sibling
+	}
+
+	// reverse the children of x
+	var rev *binomialNode[T]
+	for c := x.child; c != nil; {
+		next := c.sibling
+		c.parent = nil
+		c.sibling = rev
+		rev = c
+		c = next
+	}
+
+	h.head = h.union(h.head, rev)
+	h.count--
+	x.elem.node = nil
+
+	return x.elem.value, nil
+}
+
+// Meld moves all elements of heap o into the heap, leaving o empty. Both heaps
+// must have the same ordering.
+func (h *BinomialHeap[T]) Meld(o *BinomialHeap[T]) {
+	if h == o {
+		return
+	}
+
+	h.head = h.union(h.head, o.head)
+	h.owner = uniteOwners(h.owner, o.owner)
+	o.owner = &heapOwner{}
+	h.count += o.count
+	o.head = nil
+	o.count = 0
+}
+
+// DecreaseKey changes the value of a given element to one which is not ordered
+// after it, and moves the value up its tree until the heap property is
+// satisfied. If the element is not in the heap, or the new value is ordered
+// after the current, an error is returned.
+func (h *BinomialHeap[T]) DecreaseKey(e *BinomialElement[T], val T) error {
+	if e == nil || e.node == nil || e.owner.find() != h.owner {
+		return ErrInvalidHandle
+	}
+
+	if h.less(e.value, val) {
+		return ErrKeyOrder
+	}
+
+	e.value = val
+
+	y := e.node
+	z := y.parent
+	for z != nil && h.less(y.elem.value, z.elem.value) {
+		y.elem, z.elem = z.elem, y.elem
+		y.elem.node = y
+		z.elem.node = z
+
+		y = z
+		z = y.parent
+	}
+
+	return nil
+}
+
+// top returns the root holding the top value along with the root preceding it
+// in the list of roots.
+func (h *BinomialHeap[T]) top() (*binomialNode[T], *binomialNode[T]) {
+	var prev, tp *binomialNode[T]
+
+	tp = h.head
+	for p, x := h.head, h.head.sibling; x != nil; p, x = x, x.sibling {
+		if h.less(x.elem.value, tp.elem.value) {
+			prev = p
+			tp = x
+		}
+	}
+
+	return prev, tp
+}
+
+// union melds two lists of roots into one. The lists are first merged into a
+// single list ordered by degree, in which at most two roots share a degree.
+// The list is then traversed, and whenever two consecutive roots have the same
+// degree, they are linked, unless a third root follows with that degree too,
+// in which case the last two are linked in the next step.
+func (h *BinomialHeap[T]) union(a, b *binomialNode[T]) *binomialNode[T] {
+	head := mergeRoots(a, b)
+	if head == nil {
+		return nil
+	}
+
+	var prev *binomialNode[T]
+	x := head
+	next := x.sibling
+
+	for next != nil {
+		switch {
+		case x.degree != next.degree ||
+			(next.sibling != nil && next.sibling.degree == x.degree):
+			prev = x
+			x = next
+		case !h.less(next.elem.value, x.elem.value):
+			x.sibling = next.sibling
+			linkBinomial(next, x)
+		default:
+			if prev == nil {
+				head = next
+			} else {
+				prev.sibling = next
+			}
+
+			linkBinomial(x, next)
+			x = next
+		}
+
+		next = x.sibling
+	}
+
+	return head
+}
+
+// mergeRoots merges two lists of roots, each ordered by degree, into a single
+// list ordered by degree.
+func mergeRoots[T any](a, b *binomialNode[T]) *binomialNode[T] {
+	var head binomialNode[T]
+	tail := &head
+
+	for a != nil && b != nil {
+		if a.degree <= b.degree {
+			tail.sibling = a
+			a = a.sibling
+		} else {
+			tail.sibling = b
+			b = b.sibling
+		}
+
+		tail = tail.sibling
+	}
+
+	if a != nil {
+		tail.sibling = a
+	} else {
+		tail.sibling = b
+	}
+
+	return head.sibling
+}
+
+// linkBinomial links two binomial trees of the same degree k, by making root y
+// the leftmost child of root z, which becomes the root of a tree of degree
+// k+1.
+func linkBinomial[T any](y, z *binomialNode[T]) {
+	y.parent = z
+	y.sibling = z.child
+	z.child = y
+	z.degree++
+}
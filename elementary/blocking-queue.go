@@ -0,0 +1,199 @@
+package elementary
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Various errors a blocking queue function can return.
+var (
+	ErrQueueOverflow   = errors.New("queue overflow")
+	ErrQueueClosed     = errors.New("queue is closed")
+	ErrInvalidCapacity = errors.New("queue capacity must be at least 1")
+)
+
+// NewBlockingQueue creates a new instance of a BlockingQueue with a given
+// capacity. A blocking queue is a bounded FIFO queue, which is safe for
+// concurrent use by multiple producers and consumers. Put blocks while the
+// queue is full, and Take blocks while the queue is empty, until either the
+// operation can proceed, or the given context is done. TryPut and TryTake
+// never block, but fail if the operation cannot proceed immediately. The
+// elements are kept in a Deque guarded by a mutex. Blocked goroutines wait on
+// a channel, which is closed to wake all of them whenever an element is put or
+// taken, after which each of them checks the queue again. The channel is only
+// created once a goroutine has to wait, so no allocations are made while the
+// queue is neither full nor empty. If the capacity is less than 1, an error is
+// returned.
+func NewBlockingQueue[T any](capacity int) (*BlockingQueue[T], error) {
+	if capacity < 1 {
+		return nil, ErrInvalidCapacity
+	}
+
+	return &BlockingQueue[T]{
+		items:    NewDeque[T](),
+		capacity: capacity,
+	}, nil
+}
+
+// BlockingQueue defines a bounded blocking queue structure with a deque of
+// elements, a capacity, and channels to signal waiting producers and
+// consumers.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	items    *Deque[T]
+	capacity int
+	closed   bool
+	notFull  chan struct{}
+	notEmpty chan struct{}
+}
+
+// Put adds an element to the tail of the queue, blocking while the queue is
+// full. If the context is done before the element is added, the context's
+// error is returned. If the queue is closed, an error is returned.
+func (q *BlockingQueue[T]) Put(ctx context.Context, val T) error {
+	for {
+		q.mu.Lock()
+		err := q.put(val)
+		if err != ErrQueueOverflow {
+			q.mu.Unlock()
+			return err
+		}
+
+		wait := q.wait(&q.notFull)
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take removes and returns the head element of the queue, blocking while the
+// queue is empty. If the context is done before an element is available, the
+// context's error is returned. Once the queue is closed, the remaining
+// elements can still be taken, after which an error is returned.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		q.mu.Lock()
+		val, err := q.take()
+		if err != ErrQueueUnderflow {
+			q.mu.Unlock()
+			return val, err
+		}
+
+		wait := q.wait(&q.notEmpty)
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+// TryPut adds an element to the tail of the queue without blocking. If the
+// queue is full or closed, an error is returned.
+func (q *BlockingQueue[T]) TryPut(val T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.put(val)
+}
+
+// TryTake removes and returns the head element of the queue without blocking.
+// If the queue is empty, or closed and drained, an error is returned.
+func (q *BlockingQueue[T]) TryTake() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.take()
+}
+
+// Close closes the queue, after which no more elements can be put. Elements
+// already in the queue can still be taken. Goroutines blocked in Put are woken
+// with an error, as are goroutines blocked in Take once the queue is drained.
+// Closing a closed queue has no effect.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	q.signal(&q.notFull)
+	q.signal(&q.notEmpty)
+}
+
+// Size returns the total number of elements in the queue.
+func (q *BlockingQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.items.Size()
+}
+
+// Cap returns the capacity of the queue.
+func (q *BlockingQueue[T]) Cap() int {
+	return q.capacity
+}
+
+// put adds an element to the tail of the queue, unless it is closed or full.
+// The mutex must be held.
+func (q *BlockingQueue[T]) put(val T) error {
+	if q.closed {
+		return ErrQueueClosed
+	}
+
+	if q.items.Size() >= q.capacity {
+		return ErrQueueOverflow
+	}
+
+	q.items.PushBack(val)
+	q.signal(&q.notEmpty)
+
+	return nil
+}
+
+// take removes and returns the head element of the queue, unless it is empty.
+// The mutex must be held.
+func (q *BlockingQueue[T]) take() (T, error) {
+	val, err := q.items.PopFront()
+	if err != nil {
+		if q.closed {
+			return val, ErrQueueClosed
+		}
+
+		return val, ErrQueueUnderflow
+	}
+
+	q.signal(&q.notFull)
+
+	return val, nil
+}
+
+// wait returns a channel to wait on for a signal, creating it if no goroutine
+// is waiting already. The mutex must be held.
+func (q *BlockingQueue[T]) wait(ch *chan struct{}) chan struct{} {
+	if *ch == nil {
+		*ch = make(chan struct{})
+	}
+
+	return *ch
+}
+
+// signal wakes all goroutines waiting on a channel by closing it, and clears
+// it for future waiters. As a goroutine only waits after checking the queue
+// with the mutex held, no signal is missed. The mutex must be held.
+func (q *BlockingQueue[T]) signal(ch *chan struct{}) {
+	if *ch != nil {
+		close(*ch)
+		*ch = nil
+	}
+}
@@ -0,0 +1,239 @@
+package elementary_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/madshov/data-structures/elementary"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		capacity int
+		err      error
+	}{
+		"should return a new queue": {
+			capacity: 4,
+		},
+		"should return error for zero capacity": {
+			capacity: 0,
+			err:      elementary.ErrInvalidCapacity,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q, err := elementary.NewBlockingQueue[int](test.capacity)
+			assert.Equal(test.err, err)
+			if err == nil {
+				assert.Equal(test.capacity, q.Cap())
+				assert.Equal(0, q.Size())
+			}
+		})
+	}
+}
+
+func TestBlockingQueueTry(t *testing.T) {
+	assert := assert.New(t)
+	q, _ := elementary.NewBlockingQueue[int](2)
+
+	_, err := q.TryTake()
+	assert.Equal(elementary.ErrQueueUnderflow, err)
+
+	assert.NoError(q.TryPut(1))
+	assert.NoError(q.TryPut(2))
+	assert.Equal(elementary.ErrQueueOverflow, q.TryPut(3))
+	assert.Equal(2, q.Size())
+
+	v, err := q.TryTake()
+	assert.NoError(err)
+	assert.Equal(1, v)
+
+	v, err = q.TryTake()
+	assert.NoError(err)
+	assert.Equal(2, v)
+}
+
+func TestBlockingQueueContext(t *testing.T) {
+	assert := assert.New(t)
+	q, _ := elementary.NewBlockingQueue[int](1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := q.Take(ctx)
+	assert.Equal(context.DeadlineExceeded, err)
+
+	assert.NoError(q.Put(context.Background(), 1))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- q.Put(ctx, 2)
+	}()
+
+	cancel()
+	assert.Equal(context.Canceled, <-done)
+	assert.Equal(1, q.Size())
+}
+
+func TestBlockingQueueBlocking(t *testing.T) {
+	assert := assert.New(t)
+	q, _ := elementary.NewBlockingQueue[int](1)
+	ctx := context.Background()
+
+	// a blocked consumer is woken by a put
+	taken := make(chan int)
+	go func() {
+		v, _ := q.Take(ctx)
+		taken <- v
+	}()
+
+	assert.NoError(q.Put(ctx, 1))
+	assert.Equal(1, <-taken)
+
+	// a blocked producer is woken by a take
+	assert.NoError(q.Put(ctx, 2))
+	put := make(chan error)
+	go func() {
+		put <- q.Put(ctx, 3)
+	}()
+
+	v, err := q.Take(ctx)
+	assert.NoError(err)
+	assert.Equal(2, v)
+	assert.NoError(<-put)
+
+	v, err = q.Take(ctx)
+	assert.NoError(err)
+	assert.Equal(3, v)
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	assert := assert.New(t)
+	q, _ := elementary.NewBlockingQueue[int](2)
+	ctx := context.Background()
+
+	assert.NoError(q.Put(ctx, 1))
+	assert.NoError(q.Put(ctx, 2))
+
+	// a blocked producer is woken by close
+	put := make(chan error)
+	go func() {
+		put <- q.Put(ctx, 3)
+	}()
+
+	q.Close()
+	q.Close()
+	assert.Equal(elementary.ErrQueueClosed, <-put)
+	assert.Equal(elementary.ErrQueueClosed, q.TryPut(4))
+
+	// remaining elements are drained
+	v, err := q.Take(ctx)
+	assert.NoError(err)
+	assert.Equal(1, v)
+
+	v, err = q.TryTake()
+	assert.NoError(err)
+	assert.Equal(2, v)
+
+	_, err = q.Take(ctx)
+	assert.Equal(elementary.ErrQueueClosed, err)
+
+	_, err = q.TryTake()
+	assert.Equal(elementary.ErrQueueClosed, err)
+
+	// blocked consumers are woken by close
+	q, _ = elementary.NewBlockingQueue[int](2)
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := q.Take(ctx)
+			errs <- err
+		}()
+	}
+
+	q.Close()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		assert.Equal(elementary.ErrQueueClosed, err)
+	}
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	assert := assert.New(t)
+	const (
+		producers = 8
+		consumers = 8
+		n         = 1000
+	)
+
+	q, _ := elementary.NewBlockingQueue[int](16)
+	ctx := context.Background()
+
+	var pwg, cwg sync.WaitGroup
+	for p := range producers {
+		pwg.Add(1)
+		go func() {
+			defer pwg.Done()
+			for i := range n {
+				if i%2 == 0 {
+					assert.NoError(q.Put(ctx, p*n+i))
+				} else {
+					for q.TryPut(p*n+i) != nil {
+					}
+				}
+			}
+		}()
+	}
+
+	seen := make([][]int, consumers)
+	for c := range consumers {
+		cwg.Add(1)
+		go func() {
+			defer cwg.Done()
+			for {
+				v, err := q.Take(ctx)
+				if err != nil {
+					assert.Equal(elementary.ErrQueueClosed, err)
+					return
+				}
+
+				seen[c] = append(seen[c], v)
+			}
+		}()
+	}
+
+	pwg.Wait()
+	q.Close()
+	cwg.Wait()
+
+	// every element is taken exactly once, and the elements of each producer
+	// are taken in the order they were put
+	count := make([]int, producers*n)
+	for _, vs := range seen {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+
+		for _, v := range vs {
+			count[v]++
+			p := v / n
+			assert.Greater(v%n, last[p])
+			last[p] = v % n
+		}
+	}
+
+	for _, c := range count {
+		assert.Equal(1, c)
+	}
+}
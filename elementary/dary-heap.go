@@ -0,0 +1,169 @@
+package elementary
+
+import "errors"
+
+// Various errors a d-ary heap function can return.
+var (
+	ErrInvalidArity = errors.New("heap arity must be at least 2")
+)
+
+// NewDaryHeap creates a new instance of a d-ary heap, ordered by a less
+// function. A d-ary heap generalizes the binary heap, by letting each element
+// have up to d children rather than 2. The heap is kept as a slice, where the
+// children of the element at index i are at indices d·i+1, ..., d·i+d, and the
+// parent at index ⌊(i-1)/d⌋. A heap of n elements has height log_d(n), so
+// moving an element up is cheaper for larger d, while moving an element down
+// requires comparing d children per level, i.e. O(d·log_d(n)) comparisons.
+// Push is thus done in O(log_d(n)) time, and Pop in O(d·log_d(n)) time. As the
+// children of an element are adjacent in memory, a larger d also makes better
+// use of the cache on large heaps, and a d of 4 often outperforms a binary
+// heap. If the arity is less than 2, an error is returned.
+func NewDaryHeap[T any](d int, less func(a, b T) bool) (*DaryHeap[T], error) {
+	if d < 2 {
+		return nil, ErrInvalidArity
+	}
+
+	return &DaryHeap[T]{
+		d:    d,
+		less: less,
+	}, nil
+}
+
+// DaryHeap defines a d-ary heap structure with a slice of element values, an
+// arity and an ordering function.
+type DaryHeap[T any] struct {
+	heap []T
+	d    int
+	less func(a, b T) bool
+}
+
+// Arity returns the maximum number of children of each element.
+func (h *DaryHeap[T]) Arity() int {
+	return h.d
+}
+
+// Parent returns the index of the parent of an element at index i.
+func (h *DaryHeap[T]) Parent(i int) int {
+	return (i - 1) / h.d
+}
+
+// Child returns the index of the k'th child of an element at index i, where k
+// is between 0 and d-1. If none, -1 and an error is returned.
+func (h *DaryHeap[T]) Child(i, k int) (int, error) {
+	idx := h.d*i + k + 1
+	if k < 0 || k >= h.d || idx > len(h.heap)-1 {
+		return -1, ErrHeapOverflow
+	}
+
+	return idx, nil
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *DaryHeap[T]) IsEmpty() bool {
+	return len(h.heap) == 0
+}
+
+// Size returns the total number of elements in the heap.
+func (h *DaryHeap[T]) Size() int {
+	return len(h.heap)
+}
+
+// BuildHeap builds up the heap with a given slice of element values in O(n)
+// time, replacing its current elements. The slice is used as the backing
+// storage of the heap, and is reordered in place.
+func (h *DaryHeap[T]) BuildHeap(vals []T) {
+	h.heap = vals
+	if len(h.heap) < 2 {
+		return
+	}
+
+	for i := h.Parent(len(h.heap) - 1); i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+// Peek returns the top element value of the heap, unless the heap is empty.
+// The element is not removed.
+func (h *DaryHeap[T]) Peek() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	return h.heap[0], nil
+}
+
+// Push adds an element value to the bottom of the heap, and moves it up until
+// the heap property is satisfied.
+func (h *DaryHeap[T]) Push(val T) {
+	h.heap = append(h.heap, val)
+
+	// move the value up, shifting parents down rather than swapping
+	i := len(h.heap) - 1
+	for i > 0 {
+		p := (i - 1) / h.d
+		if !h.less(val, h.heap[p]) {
+			break
+		}
+
+		h.heap[i] = h.heap[p]
+		i = p
+	}
+
+	h.heap[i] = val
+}
+
+// Pop removes and returns the top element value of the heap, unless the heap
+// underflows. The bottom element takes its place, and is moved down until the
+// heap property is satisfied.
+func (h *DaryHeap[T]) Pop() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	top := h.heap[0]
+	n := len(h.heap) - 1
+	h.heap[0] = h.heap[n]
+
+	var zero T
+	h.heap[n] = zero
+	h.heap = h.heap[:n]
+
+	if n > 0 {
+		h.down(0)
+	}
+
+	return top, nil
+}
+
+// down moves the element at index i down the heap, by shifting its least
+// child up as long as that child is less than the element.
+func (h *DaryHeap[T]) down(i int) {
+	n := len(h.heap)
+	val := h.heap[i]
+
+	for {
+		first := h.d*i + 1
+		if first >= n {
+			break
+		}
+
+		least := first
+		last := min(first+h.d, n)
+		for c := first + 1; c < last; c++ {
+			if h.less(h.heap[c], h.heap[least]) {
+				least = c
+			}
+		}
+
+		if !h.less(h.heap[least], val) {
+			break
+		}
+
+		h.heap[i] = h.heap[least]
+		i = least
+	}
+
+	h.heap[i] = val
+}
@@ -0,0 +1,171 @@
+package elementary_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestNewDaryHeap(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		d   int
+		err error
+	}{
+		"should return error for a negative arity": {
+			d:   -1,
+			err: elementary.ErrInvalidArity,
+		},
+		"should return error for an arity of 0": {
+			d:   0,
+			err: elementary.ErrInvalidArity,
+		},
+		"should return error for an arity of 1": {
+			d:   1,
+			err: elementary.ErrInvalidArity,
+		},
+		"should create a binary heap": {
+			d: 2,
+		},
+		"should create a 5-ary heap": {
+			d: 5,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h, err := elementary.NewDaryHeap(test.d, func(a, b int) bool { return a < b })
+			assert.Equal(test.err, err)
+			if err != nil {
+				assert.Nil(h)
+				return
+			}
+
+			assert.Equal(test.d, h.Arity())
+			assert.True(h.IsEmpty())
+		})
+	}
+}
+
+func TestDaryHeap(t *testing.T) {
+	assert := assert.New(t)
+	vals := []int{5, -1, 3, 3, 0, 8, -7, 12, 3, 9, 4}
+	want := slices.Clone(vals)
+	slices.Sort(want)
+
+	for _, d := range []int{2, 3, 4, 8} {
+		h, _ := elementary.NewDaryHeap(d, func(a, b int) bool { return a < b })
+		_, err := h.Peek()
+		assert.Equal(elementary.ErrHeapUnderflow, err)
+
+		for _, v := range vals {
+			h.Push(v)
+		}
+
+		assert.Equal(len(vals), h.Size())
+		top, err := h.Peek()
+		assert.NoError(err)
+		assert.Equal(want[0], top)
+		assert.Equal(want, popAll[int](h), "arity %d", d)
+
+		_, err = h.Pop()
+		assert.Equal(elementary.ErrHeapUnderflow, err)
+	}
+}
+
+func TestDaryHeapBuildHeap(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	tests := map[string]struct {
+		d int
+	}{
+		"should build a binary heap": {
+			d: 2,
+		},
+		"should build a ternary heap": {
+			d: 3,
+		},
+		"should build an 8-ary heap": {
+			d: 8,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			less := func(a, b int) bool { return a > b }
+			h, _ := elementary.NewDaryHeap(test.d, less)
+			for _, n := range []int{1, 2, test.d, test.d + 1, 100} {
+				vals := make([]int, n)
+				for i := range vals {
+					vals[i] = r.Intn(20) - 10
+				}
+
+				want := slices.Clone(vals)
+				slices.SortFunc(want, func(a, b int) int { return b - a })
+
+				// the slice is reordered in place into the heap
+				h.BuildHeap(vals)
+				assert.Equal(n, h.Size())
+				for i := 1; i < n; i++ {
+					p := h.Parent(i)
+					assert.Equal((i-1)/test.d, p)
+					assert.False(less(vals[i], vals[p]), "element %d is greater than its parent", i)
+				}
+
+				assert.Equal(want, popAll[int](h))
+			}
+		})
+	}
+}
+
+func TestDaryHeapChild(t *testing.T) {
+	assert := assert.New(t)
+	h, _ := elementary.NewDaryHeap(3, func(a, b int) bool { return a < b })
+	h.BuildHeap([]int{1, 2, 3, 4, 5, 6})
+
+	tests := map[string]struct {
+		i, k int
+		want int
+		err  error
+	}{
+		"should return the first child of the root": {
+			i: 0, k: 0,
+			want: 1,
+		},
+		"should return the last child of the root": {
+			i: 0, k: 2,
+			want: 3,
+		},
+		"should return a child of an inner element": {
+			i: 1, k: 1,
+			want: 5,
+		},
+		"should return error for a missing child": {
+			i: 1, k: 2,
+			want: -1,
+			err:  elementary.ErrHeapOverflow,
+		},
+		"should return error for a child index out of range": {
+			i: 0, k: 3,
+			want: -1,
+			err:  elementary.ErrHeapOverflow,
+		},
+		"should return error for a negative child index": {
+			i: 0, k: -1,
+			want: -1,
+			err:  elementary.ErrHeapOverflow,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := h.Child(test.i, test.k)
+			assert.Equal(test.err, err)
+			assert.Equal(test.want, c)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package elementary
+
+import (
+	"errors"
+	"iter"
+)
+
+// Various errors a deque function can return.
+var (
+	ErrDequeUnderflow = errors.New("deque underflow")
+	ErrIndexRange     = errors.New("index out of range")
+)
+
+// minDequeCap is the smallest capacity of the buffer of a deque.
+const minDequeCap = 8
+
+// NewDeque creates a new instance of a Deque data structure - a double-ended
+// queue, where elements can be added and removed at both the head and the
+// tail. Deque is backed by a circular buffer, i.e. a slice where the head may
+// lie anywhere, and the elements wrap around from the end of the slice to the
+// start. The capacity of the buffer is always a power of two, so the position
+// of the element at index i is simply (head+i) & (cap-1). When the buffer is
+// full, its capacity is doubled, and when it is only a quarter full, its
+// capacity is halved, which keeps the memory usage proportional to the number
+// of elements. Unlike Queue, no memory is allocated per element, and all
+// elements are contiguous in memory. All push and pop operations are done in
+// O(1) amortized time, while indexed access is done in O(1) time.
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// Deque defines a double-ended queue structure with a circular buffer, the
+// position of the head element and a count of the elements in it.
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// IsEmpty checks if the deque is empty.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.count == 0
+}
+
+// Size returns the total number of elements in the deque.
+func (d *Deque[T]) Size() int {
+	return d.count
+}
+
+// PushFront adds an element to the head of the deque.
+func (d *Deque[T]) PushFront(val T) {
+	d.grow()
+	d.head = (d.head - 1) & (len(d.buf) - 1)
+	d.buf[d.head] = val
+	d.count++
+}
+
+// PushBack adds an element to the tail of the deque.
+func (d *Deque[T]) PushBack(val T) {
+	d.grow()
+	d.buf[d.pos(d.count)] = val
+	d.count++
+}
+
+// PopFront removes and returns the head element of the deque, unless the deque
+// underflows.
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if d.IsEmpty() {
+		return zero, ErrDequeUnderflow
+	}
+
+	val := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.pos(1)
+	d.count--
+	d.shrink()
+
+	return val, nil
+}
+
+// PopBack removes and returns the tail element of the deque, unless the deque
+// underflows.
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if d.IsEmpty() {
+		return zero, ErrDequeUnderflow
+	}
+
+	p := d.pos(d.count - 1)
+	val := d.buf[p]
+	d.buf[p] = zero
+	d.count--
+	d.shrink()
+
+	return val, nil
+}
+
+// Front returns the head element of the deque, unless the deque is empty. The
+// element is not removed.
+func (d *Deque[T]) Front() (T, error) {
+	return d.At(0)
+}
+
+// Back returns the tail element of the deque, unless the deque is empty. The
+// element is not removed.
+func (d *Deque[T]) Back() (T, error) {
+	return d.At(d.count - 1)
+}
+
+// At returns the element at index i of the deque, counting from the head. If
+// the index is out of range, an error is returned.
+func (d *Deque[T]) At(i int) (T, error) {
+	if i < 0 || i >= d.count {
+		var zero T
+		return zero, ErrIndexRange
+	}
+
+	return d.buf[d.pos(i)], nil
+}
+
+// Set replaces the element at index i of the deque, counting from the head. If
+// the index is out of range, an error is returned.
+func (d *Deque[T]) Set(i int, val T) error {
+	if i < 0 || i >= d.count {
+		return ErrIndexRange
+	}
+
+	d.buf[d.pos(i)] = val
+	return nil
+}
+
+// Traverse loops through each element in the deque from head to tail.
+func (d *Deque[T]) Traverse(f func(T)) {
+	for i := range d.count {
+		f(d.buf[d.pos(i)])
+	}
+}
+
+// All returns an iterator over the indices and element values in the deque
+// from head to tail.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := range d.count {
+			if !yield(i, d.buf[d.pos(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indices and element values in the
+// deque from tail to head.
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := d.count - 1; i >= 0; i-- {
+			if !yield(i, d.buf[d.pos(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// pos returns the position in the buffer of the element at index i.
+func (d *Deque[T]) pos(i int) int {
+	return (d.head + i) & (len(d.buf) - 1)
+}
+
+// grow doubles the capacity of the buffer if it is full.
+func (d *Deque[T]) grow() {
+	if d.count < len(d.buf) {
+		return
+	}
+
+	d.resize(max(minDequeCap, len(d.buf)<<1))
+}
+
+// shrink halves the capacity of the buffer if it is at most a quarter full.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minDequeCap && d.count <= len(d.buf)>>2 {
+		d.resize(len(d.buf) >> 1)
+	}
+}
+
+// resize moves the elements to a new buffer of a given capacity, with the head
+// element at the start.
+func (d *Deque[T]) resize(c int) {
+	buf := make([]T, c)
+	if d.count > 0 {
+		if d.head+d.count <= len(d.buf) {
+			copy(buf, d.buf[d.head:d.head+d.count])
+		} else {
+			n := copy(buf, d.buf[d.head:])
+			copy(buf[n:], d.buf[:d.count-n])
+		}
+	}
+
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,108 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// fifo defines the operations of a first-in, first-out queue of int values.
+type fifo struct {
+	enqueue func(int)
+	dequeue func()
+}
+
+func newFifos() map[string]func() fifo {
+	return map[string]func() fifo{
+		"Deque": func() fifo {
+			d := elementary.NewDeque[int]()
+			return fifo{d.PushBack, func() { d.PopFront() }}
+		},
+		"Queue": func() fifo {
+			q := elementary.NewQueue()
+			return fifo{q.Enqueue, func() { q.Dequeue() }}
+		},
+		"DSQueue": func() fifo {
+			q := elementary.NewDSQueue()
+			return fifo{q.Enqueue, func() { q.Dequeue() }}
+		},
+	}
+}
+
+func BenchmarkFifoFillDrain(b *testing.B) {
+	const n = 10000
+
+	for name, mk := range newFifos() {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				q := mk()
+				for i := range n {
+					q.enqueue(i)
+				}
+
+				for range n {
+					q.dequeue()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkFifoSteady(b *testing.B) {
+	const n = 1000
+
+	for name, mk := range newFifos() {
+		b.Run(name, func(b *testing.B) {
+			q := mk()
+			for i := range n {
+				q.enqueue(i)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := range b.N {
+				q.enqueue(i)
+				q.dequeue()
+			}
+		})
+	}
+}
+
+func BenchmarkDequeBothEnds(b *testing.B) {
+	const n = 10000
+
+	b.ReportAllocs()
+	for range b.N {
+		d := elementary.NewDeque[int]()
+		for i := range n {
+			if i%2 == 0 {
+				d.PushFront(i)
+			} else {
+				d.PushBack(i)
+			}
+		}
+
+		for i := range n {
+			if i%2 == 0 {
+				d.PopBack()
+			} else {
+				d.PopFront()
+			}
+		}
+	}
+}
+
+func BenchmarkDequeAt(b *testing.B) {
+	const n = 10000
+
+	d := elementary.NewDeque[int]()
+	for i := range n {
+		d.PushFront(i)
+	}
+
+	b.ResetTimer()
+	for i := range b.N {
+		d.At(i % n)
+	}
+}
@@ -0,0 +1,268 @@
+package elementary_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// dequeValues returns the values of a deque from head to tail.
+func dequeValues[T any](d *elementary.Deque[T]) []T {
+	var vals []T
+	d.Traverse(func(v T) {
+		vals = append(vals, v)
+	})
+
+	return vals
+}
+
+// assertDeque asserts that a deque holds the given values, by reading them
+// from both ends and by index.
+func assertDeque(t *testing.T, d *elementary.Deque[int], want []int) {
+	t.Helper()
+	assert := assert.New(t)
+	assert.Equal(len(want), d.Size())
+	assert.Equal(len(want) == 0, d.IsEmpty())
+	assert.Equal(want, dequeValues(d))
+
+	for i, v := range want {
+		got, err := d.At(i)
+		assert.NoError(err)
+		assert.Equal(v, got)
+	}
+
+	if len(want) > 0 {
+		front, _ := d.Front()
+		back, _ := d.Back()
+		assert.Equal(want[0], front)
+		assert.Equal(want[len(want)-1], back)
+	}
+}
+
+func TestDeque(t *testing.T) {
+	tests := map[string]struct {
+		ops  func(d *elementary.Deque[int])
+		want []int
+	}{
+		"should hold nothing when new": {
+			ops: func(d *elementary.Deque[int]) {},
+		},
+		"should push at both ends": {
+			ops: func(d *elementary.Deque[int]) {
+				d.PushBack(2)
+				d.PushFront(1)
+				d.PushBack(3)
+				d.PushFront(0)
+			},
+			want: []int{0, 1, 2, 3},
+		},
+		"should wrap around the end of the buffer": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 8 {
+					d.PushBack(i)
+				}
+				for range 5 {
+					d.PopFront()
+				}
+				for i := 8; i < 13; i++ {
+					d.PushBack(i)
+				}
+			},
+			want: []int{5, 6, 7, 8, 9, 10, 11, 12},
+		},
+		"should wrap around the start of the buffer": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 5 {
+					d.PushFront(i)
+				}
+				d.PopBack()
+				d.PushBack(-1)
+			},
+			want: []int{4, 3, 2, 1, -1},
+		},
+		"should fill the minimum capacity": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 8 {
+					d.PushBack(i)
+				}
+			},
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+		"should grow past the minimum capacity": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 9 {
+					d.PushBack(i)
+				}
+			},
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		"should grow a wrapped buffer": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 4 {
+					d.PushBack(i)
+				}
+				for i := 1; i <= 5; i++ {
+					d.PushFront(-i)
+				}
+			},
+			want: []int{-5, -4, -3, -2, -1, 0, 1, 2, 3},
+		},
+		"should shrink down to the minimum capacity": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 17 {
+					d.PushBack(i)
+				}
+				for range 14 {
+					d.PopFront()
+				}
+			},
+			want: []int{14, 15, 16},
+		},
+		"should shrink a wrapped buffer": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 16 {
+					d.PushBack(i)
+				}
+				for range 12 {
+					d.PopFront()
+				}
+				for i := 16; i < 20; i++ {
+					d.PushBack(i)
+				}
+				for range 4 {
+					d.PopBack()
+				}
+				d.PopFront()
+			},
+			want: []int{13, 14, 15},
+		},
+		"should be reusable after being emptied": {
+			ops: func(d *elementary.Deque[int]) {
+				for i := range 20 {
+					d.PushFront(i)
+				}
+				for range 20 {
+					d.PopBack()
+				}
+				d.PushBack(1)
+			},
+			want: []int{1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := elementary.NewDeque[int]()
+			test.ops(d)
+			assertDeque(t, d, test.want)
+		})
+	}
+}
+
+func TestDequeUnderflow(t *testing.T) {
+	assert := assert.New(t)
+	d := elementary.NewDeque[string]()
+
+	_, err := d.PopFront()
+	assert.Equal(elementary.ErrDequeUnderflow, err)
+	_, err = d.PopBack()
+	assert.Equal(elementary.ErrDequeUnderflow, err)
+	_, err = d.Front()
+	assert.Equal(elementary.ErrIndexRange, err)
+	_, err = d.Back()
+	assert.Equal(elementary.ErrIndexRange, err)
+
+	d.PushBack("a")
+	v, err := d.PopBack()
+	assert.NoError(err)
+	assert.Equal("a", v)
+
+	_, err = d.PopFront()
+	assert.Equal(elementary.ErrDequeUnderflow, err)
+	assert.Equal(0, d.Size())
+}
+
+func TestDequeAtSet(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		index int
+		err   error
+	}{
+		"should access the head": {
+			index: 0,
+		},
+		"should access the tail": {
+			index: 4,
+		},
+		"should return error for a negative index": {
+			index: -1,
+			err:   elementary.ErrIndexRange,
+		},
+		"should return error for an index past the tail": {
+			index: 5,
+			err:   elementary.ErrIndexRange,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// the elements wrap around the start of the buffer
+			d := elementary.NewDeque[int]()
+			for i := range 5 {
+				d.PushFront(4 - i)
+			}
+
+			v, err := d.At(test.index)
+			assert.Equal(test.err, err)
+			if err != nil {
+				assert.Equal(0, v)
+				assert.Equal(test.err, d.Set(test.index, 100))
+				assertDeque(t, d, []int{0, 1, 2, 3, 4})
+				return
+			}
+
+			assert.Equal(test.index, v)
+			assert.NoError(d.Set(test.index, 100))
+			v, _ = d.At(test.index)
+			assert.Equal(100, v)
+		})
+	}
+}
+
+func TestDequeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := elementary.NewDeque[int]()
+	var model []int
+
+	for i := range 5000 {
+		switch op := r.Intn(5); {
+		case op == 0 || (op == 4 && len(model) < 50):
+			d.PushFront(i)
+			model = append([]int{i}, model...)
+		case op == 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case op == 2:
+			v, err := d.PopFront()
+			if len(model) == 0 {
+				assert.Equal(t, elementary.ErrDequeUnderflow, err)
+				continue
+			}
+			assert.Equal(t, model[0], v)
+			model = model[1:]
+		default:
+			v, err := d.PopBack()
+			if len(model) == 0 {
+				assert.Equal(t, elementary.ErrDequeUnderflow, err)
+				continue
+			}
+			assert.Equal(t, model[len(model)-1], v)
+			model = model[:len(model)-1]
+		}
+	}
+
+	assertDeque(t, d, model)
+}
@@ -1,13 +1,18 @@
 package elementary
 
+import "iter"
+
 // NewDSQueue creates a new instance of a queue structure based on two stacks.
-// Like Queue, DSQueue implements a LIFO policy, with the basic operations peek,
-// enqueue and dequeue. DSQueue contains two stacks called S0 and s1. s0 will be
-// used as the main stack, while s1 will be used to queue elements until s0 is
-// empty. Elements may therefore, be popped from s0 and pushed to s1 within the
-// same operation. The LIFO policy will always be maintained. The enqueue
-// operation is done in O(1) time, while both peek and dequeue are done in O(n),
-// so there is no avantage in using DSQueue compared to using Queue.
+// Like Queue, DSQueue implements a FIFO policy, with the basic operations
+// peek, enqueue and dequeue. DSQueue contains two stacks called s0 and s1. s0
+// is the back of the queue, where elements are enqueued, while s1 is the front
+// of the queue, where elements are dequeued. When s1 is empty, all elements of
+// s0 are moved to s1, which reverses their order, so that the element enqueued
+// first ends up at the top of s1. Each element is thus moved at most once, and
+// although a single dequeue may take O(n) time, any sequence of n operations
+// takes O(n) time in total. The enqueue operation is done in O(1) time, while
+// both peek and dequeue are done in O(1) amortized time. Elements are moved
+// by relinking them, so no memory is allocated when elements are moved.
 func NewDSQueue() *DSQueue {
 	s0 := NewStack()
 	s1 := NewStack()
@@ -33,14 +38,10 @@ func (q *DSQueue) IsEmpty() bool {
 
 // Peek returns the head element of the queue. The element is not dequeued. The
 // head element is the top element of s1. If empty, all elements of s0, must be
-// pushed to s1 first.
+// moved to s1 first.
 func (q *DSQueue) Peek() *StackElement {
 	if q.s1.IsEmpty() {
-		n, _ := q.s0.Pop()
-		for n != nil {
-			q.s1.Push(n.Value)
-			n, _ = q.s0.Pop()
-		}
+		q.shift()
 	}
 
 	return q.s1.Peek()
@@ -54,14 +55,10 @@ func (q *DSQueue) Enqueue(val int) {
 
 // Dequeue removes and returns the head element of the queue, unless the queue
 // underflows. The head element is the top element of s1. If empty, all elements
-// of s0, must be pused to stack1 first.
+// of s0, must be moved to s1 first.
 func (q *DSQueue) Dequeue() (*StackElement, error) {
 	if q.s1.IsEmpty() {
-		e, _ := q.s0.Pop()
-		for e != nil {
-			q.s1.Push(e.Value)
-			e, _ = q.s0.Pop()
-		}
+		q.shift()
 	}
 
 	e, err := q.s1.Pop()
@@ -73,51 +70,58 @@ func (q *DSQueue) Dequeue() (*StackElement, error) {
 	return e, nil
 }
 
-// Traverse loops through each element in the queue. Elements will be moved
-// around between s0 and s1, but the initial state will reoptained at the end.
+// Traverse loops through each element in the queue from head to tail, without
+// altering the queue. The elements of s1 are visited from top to bottom, and
+// then the elements of s0 from bottom to top, which requires O(n) extra
+// storage for the elements of s0.
 func (q *DSQueue) Traverse(f func(*StackElement)) {
-	var c int
-
-	// pop and call function on each element
-	// in s1 and push to s0
-	for {
-		e, _ := q.s1.Pop()
-		if e == nil {
-			break
-		} else {
-			f(e)
-			q.s0.Push(e.Value)
-			c++
-		}
-	}
+	q.s1.Traverse(f)
 
-	// restore s1 to initial state
-	for c > 0 {
-		e, _ := q.s0.Pop()
-		q.s1.Push(e.Value)
-		c--
+	es := make([]*StackElement, 0, q.s0.Size())
+	q.s0.Traverse(func(e *StackElement) {
+		es = append(es, e)
+	})
+
+	for i := len(es) - 1; i >= 0; i-- {
+		f(es[i])
 	}
+}
+
+// All returns an iterator over the element values in the queue from head to
+// tail, i.e. the elements of s1 from top to bottom followed by the elements of
+// s0 from bottom to top.
+func (q *DSQueue) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for v := range q.s1.All() {
+			if !yield(v) {
+				return
+			}
+		}
 
-	// pop all elements from s0 and push
-	// to s1
-	for {
-		e, _ := q.s0.Pop()
-		if e == nil {
-			break
-		} else {
-			q.s1.Push(e.Value)
-			c++
+		for v := range q.s0.Backward() {
+			if !yield(v) {
+				return
+			}
 		}
 	}
+}
+
+// Backward returns an iterator over the element values in the queue from tail
+// to head, i.e. the elements of s0 from top to bottom followed by the elements
+// of s1 from bottom to top.
+func (q *DSQueue) Backward() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for v := range q.s0.All() {
+			if !yield(v) {
+				return
+			}
+		}
 
-	// pop and call function on each pushed
-	// element in s1 and push to stack to
-	// restore to initial state
-	for c > 0 {
-		e, _ := q.s1.Pop()
-		f(e)
-		q.s0.Push(e.Value)
-		c--
+		for v := range q.s1.Backward() {
+			if !yield(v) {
+				return
+			}
+		}
 	}
 }
 
@@ -125,3 +129,18 @@ func (q *DSQueue) Traverse(f func(*StackElement)) {
 func (q *DSQueue) Size() int {
 	return q.count
 }
+
+// shift moves all elements of s0 to s1, by relinking each element from the top
+// of s0 to the top of s1.
+func (q *DSQueue) shift() {
+	for e := q.s0.top; e != nil; {
+		next := e.next
+		e.next = q.s1.top
+		q.s1.top = e
+		e = next
+	}
+
+	q.s1.count += q.s0.count
+	q.s0.top = nil
+	q.s0.count = 0
+}
@@ -0,0 +1,114 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// dsQueueValues returns the values of a queue from head to tail.
+func dsQueueValues(q *elementary.DSQueue) []int {
+	var vals []int
+	q.Traverse(func(e *elementary.StackElement) {
+		vals = append(vals, e.Value)
+	})
+
+	return vals
+}
+
+func TestDSQueue(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []int
+	}{
+		"should dequeue nothing from an empty queue": {},
+		"should dequeue a single value": {
+			vals: []int{1},
+		},
+		"should dequeue values in order of enqueueing": {
+			vals: []int{1, 2, 3, 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q := elementary.NewDSQueue()
+			for _, v := range test.vals {
+				q.Enqueue(v)
+			}
+
+			assert.Equal(len(test.vals), q.Size())
+			if len(test.vals) > 0 {
+				assert.Equal(test.vals[0], q.Peek().Value)
+			} else {
+				assert.Nil(q.Peek())
+			}
+			assert.Equal(test.vals, dsQueueValues(q))
+
+			var got []int
+			for !q.IsEmpty() {
+				e, err := q.Dequeue()
+				assert.NoError(err)
+				got = append(got, e.Value)
+			}
+			assert.Equal(test.vals, got)
+			assert.Equal(0, q.Size())
+
+			_, err := q.Dequeue()
+			assert.Equal(elementary.ErrStackUnderflow, err)
+		})
+	}
+}
+
+func TestDSQueueInterleaved(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewDSQueue()
+	var model, got, want []int
+
+	// dequeues empty the front stack while the back stack holds elements, so
+	// elements are moved between the stacks repeatedly
+	for i := range 100 {
+		q.Enqueue(i)
+		model = append(model, i)
+
+		if i%3 == 1 {
+			e, err := q.Dequeue()
+			assert.NoError(err)
+			got = append(got, e.Value)
+			want = append(want, model[0])
+			model = model[1:]
+		}
+
+		assert.Equal(len(model), q.Size())
+		assert.Equal(model, dsQueueValues(q))
+	}
+
+	assert.Equal(want, got)
+}
+
+func TestDSQueueRelink(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewDSQueue()
+	for i := range 5 {
+		q.Enqueue(i)
+	}
+
+	// the elements are collected before they are moved to the front stack
+	var es []*elementary.StackElement
+	q.Traverse(func(e *elementary.StackElement) {
+		es = append(es, e)
+	})
+
+	// Traverse leaves the queue intact
+	assert.Equal(5, q.Size())
+
+	// moving the elements relinks them, rather than allocating new ones
+	assert.Same(es[0], q.Peek())
+	for _, want := range es {
+		e, err := q.Dequeue()
+		assert.NoError(err)
+		assert.Same(want, e)
+	}
+}
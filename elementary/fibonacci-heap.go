@@ -0,0 +1,325 @@
+package elementary
+
+import "math"
+
+// NewFibonacciHeap creates a new instance of a Fibonacci heap, ordered by a
+// less function. A Fibonacci heap is a collection of heap-ordered trees, with
+// the roots kept in a circular, doubly linked list, along with a pointer to
+// the root holding the top value. Unlike a binomial heap, the trees are not
+// restructured until they have to be. Insert and Meld simply add trees to the
+// list of roots, and DecreaseKey cuts the node out of its tree and makes it a
+// root. The work is done by Pop, which consolidates the list of roots by
+// linking trees of equal degree, until no two roots share a degree. To keep
+// the trees bushy, a node is marked when it loses a child, and is cut from its
+// own parent as well once it loses a second child. This ensures that a node of
+// degree k has at least Fₖ₊₂ ≥ φᵏ descendants, where Fₖ is the k'th Fibonacci
+// number, hence the name. Insert, Meld, Peek and DecreaseKey are done in O(1)
+// amortized time, while Pop is done in O(lg n) amortized time, which makes the
+// heap well suited for algorithms such as Dijkstra's, where decrease-key
+// operations outnumber pops.
+func NewFibonacciHeap[T any](less func(a, b T) bool) *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{
+		less:  less,
+		owner: &heapOwner{},
+	}
+}
+
+// FibonacciHeap defines a Fibonacci heap structure with a pointer to the root
+// holding the top value, an ordering function, a count of the elements in it
+// and the owner of its elements.
+type FibonacciHeap[T any] struct {
+	top   *FibonacciElement[T]
+	less  func(a, b T) bool
+	count int
+	owner *heapOwner
+}
+
+// FibonacciElement defines an element of the Fibonacci heap, which is a node
+// of one of its trees with a pointer to its parent, one of its children, and
+// its left and right siblings, as well as to its owner, which identifies the
+// heap holding it.
+type FibonacciElement[T any] struct {
+	parent *FibonacciElement[T]
+	child  *FibonacciElement[T]
+	left   *FibonacciElement[T]
+	right  *FibonacciElement[T]
+	degree int
+	mark   bool
+	owner  *heapOwner
+	value  T
+}
+
+// Value returns the value of the element.
+func (e *FibonacciElement[T]) Value() T {
+	return e.value
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *FibonacciHeap[T]) IsEmpty() bool {
+	return h.top == nil
+}
+
+// Size returns the total number of elements in the heap.
+func (h *FibonacciHeap[T]) Size() int {
+	return h.count
+}
+
+// Insert adds a value to the heap as a new root, and returns the element
+// holding it.
+func (h *FibonacciHeap[T]) Insert(val T) *FibonacciElement[T] {
+	e := &FibonacciElement[T]{
+		value: val,
+		owner: h.owner,
+	}
+
+	e.left = e
+	e.right = e
+
+	h.addRoot(e)
+	h.count++
+
+	return e
+}
+
+// Push adds a value to the heap.
+func (h *FibonacciHeap[T]) Push(val T) {
+	h.Insert(val)
+}
+
+// Peek returns the top value of the heap, unless the heap is empty. The value
+// is not removed.
+func (h *FibonacciHeap[T]) Peek() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	return h.top.value, nil
+}
+
+// Pop removes and returns the top value of the heap, unless the heap
+// underflows. The children of the root holding the top value become roots,
+// after which the list of roots is consolidated.
+func (h *FibonacciHeap[T]) Pop() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	z := h.top
+
+	// move each child of z to the list of roots
+	for z.child != nil {
+		x := z.child
+		if x.right == x {
+			z.child = nil
+		} else {
+			z.child = x.right
+			unlinkFibonacci(x)
+		}
+
+		x.parent = nil
+		x.left = x
+		x.right = x
+		spliceFibonacci(z, x)
+	}
+
+	if z.right == z {
+		h.top = nil
+	} else {
+		h.top = z.right
+		unlinkFibonacci(z)
+		h.consolidate()
+	}
+
+	h.count--
+
+	// detach z, so it is no longer recognized as an element of the heap
+	z.left = nil
+	z.right = nil
+
+	return z.value, nil
+}
+
+// Meld moves all elements of heap o into the heap, by concatenating the lists
+// of roots, leaving o empty. Both heaps must have the same ordering.
+func (h *FibonacciHeap[T]) Meld(o *FibonacciHeap[T]) {
+	if h == o || o.top == nil {
+		return
+	}
+
+	if h.top == nil {
+		h.top = o.top
+	} else {
+		// concatenate the two circular lists
+		a, b := h.top.right, o.top.left
+		h.top.right = o.top
+		o.top.left = h.top
+		a.left = b
+		b.right = a
+
+		if h.less(o.top.value, h.top.value) {
+			h.top = o.top
+		}
+	}
+
+	h.count += o.count
+	h.owner = uniteOwners(h.owner, o.owner)
+	o.owner = &heapOwner{}
+	o.top = nil
+	o.count = 0
+}
+
+// DecreaseKey changes the value of a given element to one which is not ordered
+// after it. If the heap property is violated, the element is cut from its
+// parent and made a root, followed by a cascading cut of the parent. If the
+// element is not in the heap, or the new value is ordered after the current,
+// an error is returned.
+func (h *FibonacciHeap[T]) DecreaseKey(e *FibonacciElement[T], val T) error {
+	if e == nil || e.left == nil || e.owner.find() != h.owner {
+		return ErrInvalidHandle
+	}
+
+	if h.less(e.value, val) {
+		return ErrKeyOrder
+	}
+
+	e.value = val
+
+	y := e.parent
+	if y != nil && h.less(e.value, y.value) {
+		h.cut(e, y)
+		h.cascadingCut(y)
+	}
+
+	if h.less(e.value, h.top.value) {
+		h.top = e
+	}
+
+	return nil
+}
+
+// addRoot adds a single node x to the list of roots, and updates the top of
+// the heap.
+func (h *FibonacciHeap[T]) addRoot(x *FibonacciElement[T]) {
+	if h.top == nil {
+		h.top = x
+		return
+	}
+
+	spliceFibonacci(h.top, x)
+	if h.less(x.value, h.top.value) {
+		h.top = x
+	}
+}
+
+// consolidate links roots of equal degree until every root has a distinct
+// degree. The root with the lesser value becomes the parent of the other. The
+// degree of any node is bounded by log_φ(n), which bounds the number of roots
+// left.
+func (h *FibonacciHeap[T]) consolidate() {
+	var roots []*FibonacciElement[T]
+	x := h.top
+	for {
+		roots = append(roots, x)
+		x = x.right
+		if x == h.top {
+			break
+		}
+	}
+
+	d := int(math.Log(float64(h.count))/math.Log(math.Phi)) + 2
+	a := make([]*FibonacciElement[T], d)
+
+	for _, x := range roots {
+		x.left = x
+		x.right = x
+
+		for x.degree < len(a) && a[x.degree] != nil {
+			y := a[x.degree]
+			if h.less(y.value, x.value) {
+				x, y = y, x
+			}
+
+			a[x.degree] = nil
+			linkFibonacci(y, x)
+		}
+
+		for x.degree >= len(a) {
+			a = append(a, nil)
+		}
+
+		a[x.degree] = x
+	}
+
+	h.top = nil
+	for _, x := range a {
+		if x != nil {
+			h.addRoot(x)
+		}
+	}
+}
+
+// cut removes node x from the children of its parent y, and makes x a root.
+func (h *FibonacciHeap[T]) cut(x, y *FibonacciElement[T]) {
+	if x.right == x {
+		y.child = nil
+	} else {
+		if y.child == x {
+			y.child = x.right
+		}
+
+		unlinkFibonacci(x)
+	}
+
+	y.degree--
+
+	x.parent = nil
+	x.mark = false
+	x.left = x
+	x.right = x
+	spliceFibonacci(h.top, x)
+}
+
+// cascadingCut marks node y when it loses its first child, and cuts it from
+// its parent when it loses its second, continuing up the tree.
+func (h *FibonacciHeap[T]) cascadingCut(y *FibonacciElement[T]) {
+	for z := y.parent; z != nil; z = y.parent {
+		if !y.mark {
+			y.mark = true
+			return
+		}
+
+		h.cut(y, z)
+		y = z
+	}
+}
+
+// linkFibonacci makes root y a child of root x.
+func linkFibonacci[T any](y, x *FibonacciElement[T]) {
+	y.parent = x
+	y.mark = false
+
+	if x.child == nil {
+		x.child = y
+	} else {
+		spliceFibonacci(x.child, y)
+	}
+
+	x.degree++
+}
+
+// spliceFibonacci inserts a single node x to the right of node a in a
+// circular, doubly linked list.
+func spliceFibonacci[T any](a, x *FibonacciElement[T]) {
+	x.left = a
+	x.right = a.right
+	a.right.left = x
+	a.right = x
+}
+
+// unlinkFibonacci removes node x from its circular, doubly linked list.
+func unlinkFibonacci[T any](x *FibonacciElement[T]) {
+	x.left.right = x.right
+	x.right.left = x.left
+}
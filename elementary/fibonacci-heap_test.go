@@ -0,0 +1,148 @@
+package elementary_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestFibonacciHeap(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []int
+		want []int
+	}{
+		"should pop nothing from an empty heap": {},
+		"should pop a single value": {
+			vals: []int{7},
+			want: []int{7},
+		},
+		"should pop values with duplicates and negatives in order": {
+			vals: []int{5, -1, 3, 3, 0, 8, -7, 12, 3},
+			want: []int{-7, -1, 0, 3, 3, 3, 5, 8, 12},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := elementary.NewFibonacciHeap(func(a, b int) bool { return a < b })
+			for _, v := range test.vals {
+				h.Push(v)
+			}
+
+			assert.Equal(len(test.vals), h.Size())
+			top, err := h.Peek()
+			if len(test.want) > 0 {
+				assert.NoError(err)
+				assert.Equal(test.want[0], top)
+			} else {
+				assert.Equal(elementary.ErrHeapUnderflow, err)
+			}
+
+			assert.Equal(test.want, popAll[int](h))
+
+			_, err = h.Pop()
+			assert.Equal(elementary.ErrHeapUnderflow, err)
+		})
+	}
+}
+
+func TestFibonacciHeapMeld(t *testing.T) {
+	assert := assert.New(t)
+	less := func(a, b int) bool { return a < b }
+	h := elementary.NewFibonacciHeap(less)
+	o := elementary.NewFibonacciHeap(less)
+	for _, v := range []int{4, 9, 1} {
+		h.Push(v)
+	}
+	for _, v := range []int{6, 0, 2, 8} {
+		o.Push(v)
+	}
+
+	h.Meld(o)
+	h.Meld(h)
+	assert.Equal(7, h.Size())
+	assert.True(o.IsEmpty())
+	assert.Equal([]int{0, 1, 2, 4, 6, 8, 9}, popAll[int](h))
+
+	// the emptied heap is reusable
+	o.Push(3)
+	assert.Equal([]int{3}, popAll[int](o))
+}
+
+func TestFibonacciHeapDecreaseKey(t *testing.T) {
+	assert := assert.New(t)
+	less := func(a, b int) bool { return a < b }
+	h := elementary.NewFibonacciHeap(less)
+	handles := make(map[int]*elementary.FibonacciElement[int])
+	for _, v := range []int{10, 20, 30, 40, 50, 60, 70} {
+		handles[v] = h.Insert(v)
+	}
+
+	assert.NoError(h.DecreaseKey(handles[70], 5))
+	assert.Equal(5, handles[70].Value())
+	top, _ := h.Peek()
+	assert.Equal(5, top)
+
+	// a value ordered after the current is rejected
+	assert.Equal(elementary.ErrKeyOrder, h.DecreaseKey(handles[40], 45))
+	assert.Equal(40, handles[40].Value())
+
+	// an equal value is accepted
+	assert.NoError(h.DecreaseKey(handles[40], 40))
+
+	// a popped handle is rejected
+	v, _ := h.Pop()
+	assert.Equal(5, v)
+	assert.Equal(elementary.ErrInvalidHandle, h.DecreaseKey(handles[70], 0))
+	assert.Equal(elementary.ErrInvalidHandle, h.DecreaseKey(nil, 0))
+
+	// a handle of another heap is rejected, until the heaps are melded
+	o := elementary.NewFibonacciHeap(less)
+	x := o.Insert(35)
+	assert.Equal(elementary.ErrInvalidHandle, h.DecreaseKey(x, 1))
+	assert.Equal(6, h.Size())
+
+	h.Meld(o)
+	assert.NoError(h.DecreaseKey(x, 1))
+	assert.Equal(elementary.ErrInvalidHandle, o.DecreaseKey(x, 0))
+	assert.Equal([]int{1, 10, 20, 30, 40, 50, 60}, popAll[int](h))
+}
+
+func TestFibonacciHeapDecreaseKeyOrder(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+	h := elementary.NewFibonacciHeap(func(a, b int) bool { return a < b })
+
+	// values are distinct multiples of 1000, so that decreased values stay
+	// distinct, and popped values identify their elements
+	var handles []*elementary.FibonacciElement[int]
+	for _, v := range r.Perm(200) {
+		handles = append(handles, h.Insert(v*1000))
+	}
+
+	popped := make(map[int]bool)
+	for range 20 {
+		v, _ := h.Pop()
+		popped[v] = true
+	}
+
+	var want []int
+	for i, e := range handles {
+		if popped[e.Value()] {
+			continue
+		}
+
+		if i%2 == 0 {
+			assert.NoError(h.DecreaseKey(e, e.Value()-r.Intn(100)*1000-i-1))
+		}
+		want = append(want, e.Value())
+	}
+
+	slices.Sort(want)
+	assert.Equal(want, popAll[int](h))
+}
@@ -0,0 +1,50 @@
+package elementary
+
+// heapOwner identifies the meldable heap holding an element, so that handles
+// from other heaps can be rejected. Every element points to the owner of the
+// heap it was inserted into, and when two heaps are melded, their owners are
+// united as sets of a disjoint-set forest, rather than updating the elements
+// of one of them. The owner of an element is thus the root of the tree its
+// owner belongs to. With union by rank and path compression, finding it takes
+// O(α(n)) amortized time, where α is the very slowly growing inverse of the
+// Ackermann function.
+type heapOwner struct {
+	parent *heapOwner
+	rank   int
+}
+
+// find returns the root of the tree of the owner, and points every owner on
+// the path directly to it.
+func (o *heapOwner) find() *heapOwner {
+	root := o
+	for root.parent != nil {
+		root = root.parent
+	}
+
+	for o != root {
+		next := o.parent
+		o.parent = root
+		o = next
+	}
+
+	return root
+}
+
+// uniteOwners unites the trees of two root owners, by making the root of
+// lower rank a child of the other, and returns the new root.
+func uniteOwners(a, b *heapOwner) *heapOwner {
+	if a == b {
+		return a
+	}
+
+	if a.rank < b.rank {
+		a, b = b, a
+	}
+
+	b.parent = a
+	if a.rank == b.rank {
+		a.rank++
+	}
+
+	return a
+}
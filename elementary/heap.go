@@ -0,0 +1,231 @@
+package elementary
+
+import "cmp"
+
+// Heaper defines the operations shared by all heaps in the package, so that
+// they can be used interchangeably. The top of a heap is its maximum value for
+// a MaxHeap, and its least value according to the ordering function for the
+// other heaps.
+type Heaper[T any] interface {
+	IsEmpty() bool
+	Size() int
+	Push(val T)
+	Peek() (T, error)
+	Pop() (T, error)
+}
+
+// NewHeap creates a new instance of a binary heap holding values of any type,
+// where the ordering is given by a less function. The heap is kept as a slice,
+// where the children of the element at index i are at indices 2i+1 and 2i+2,
+// and the heap property is that no element is less than its parent, i.e. the
+// top of the heap is the least element according to less. A less function of
+// a < b gives a min-heap, a > b gives a max-heap, while a less function
+// comparing a field of a struct orders the heap by that field. Push, Pop,
+// Remove and Fix are done in O(lg n) time, while Peek is done in O(1) time.
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{
+		less: less,
+	}
+}
+
+// NewHeapFromSlice creates a new instance of a heap ordered by a less function,
+// built from a given slice of element values in O(n) time. The slice is used
+// as the backing storage of the heap, and is reordered in place.
+func NewHeapFromSlice[T any](vals []T, less func(a, b T) bool) *Heap[T] {
+	h := NewHeap(less)
+	h.BuildHeap(vals)
+
+	return h
+}
+
+// NewMinHeapOf creates a new instance of a heap where the top element is the
+// smallest value.
+func NewMinHeapOf[T cmp.Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool {
+		return a < b
+	})
+}
+
+// NewMaxHeapOf creates a new instance of a heap where the top element is the
+// largest value.
+func NewMaxHeapOf[T cmp.Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool {
+		return a > b
+	})
+}
+
+// Heap defines a heap structure with a slice of element values and an
+// ordering function.
+type Heap[T any] struct {
+	heap []T
+	less func(a, b T) bool
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.heap) == 0
+}
+
+// Size returns the total number of elements in the heap.
+func (h *Heap[T]) Size() int {
+	return len(h.heap)
+}
+
+// BuildHeap builds up the heap with a given slice of element values, replacing
+// its current elements. Rather than pushing the values one by one in
+// O(n lg n) time, each element with children is moved down, from the last one
+// up to the root. As the subtrees of an element are heaps by the time it is
+// moved down, and most elements lie close to the bottom of the heap, the total
+// work is bounded by Σ n/2ʰ⁺¹·O(h) = O(n) over the heights h.
+func (h *Heap[T]) BuildHeap(vals []T) {
+	h.heap = vals
+
+	for i := len(h.heap)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+}
+
+// Peek returns the top element value of the heap, unless the heap is empty.
+// The element is not removed.
+func (h *Heap[T]) Peek() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	return h.heap[0], nil
+}
+
+// Push adds an element value to the bottom of the heap, and moves it up until
+// the heap property is satisfied.
+func (h *Heap[T]) Push(val T) {
+	h.heap = append(h.heap, val)
+	h.up(len(h.heap) - 1)
+}
+
+// Pop removes and returns the top element value of the heap, unless the heap
+// underflows. The bottom element takes its place, and is moved down until the
+// heap property is satisfied.
+func (h *Heap[T]) Pop() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	top := h.heap[0]
+	n := len(h.heap) - 1
+	h.heap[0] = h.heap[n]
+
+	var zero T
+	h.heap[n] = zero
+	h.heap = h.heap[:n]
+	h.down(0)
+
+	return top, nil
+}
+
+// Remove removes and returns the element value at index i of the heap. The
+// bottom element takes its place, and is moved either up or down until the
+// heap property is satisfied. If the index is out of range, an error is
+// returned.
+func (h *Heap[T]) Remove(i int) (T, error) {
+	if i < 0 || i >= len(h.heap) {
+		var zero T
+		return zero, ErrHeapOverflow
+	}
+
+	val := h.heap[i]
+	n := len(h.heap) - 1
+	h.heap[i] = h.heap[n]
+
+	var zero T
+	h.heap[n] = zero
+	h.heap = h.heap[:n]
+
+	if i < n {
+		h.Fix(i)
+	}
+
+	return val, nil
+}
+
+// Fix restores the heap property after the element value at index i has
+// changed, by moving it either up or down the heap. This is cheaper than
+// removing the element and pushing it again. If the index is out of range, an
+// error is returned.
+func (h *Heap[T]) Fix(i int) error {
+	if i < 0 || i >= len(h.heap) {
+		return ErrHeapOverflow
+	}
+
+	if !h.down(i) {
+		h.up(i)
+	}
+
+	return nil
+}
+
+// At returns the element value at index i of the heap, e.g. to find the index
+// of an element to remove or fix. If the index is out of range, an error is
+// returned.
+func (h *Heap[T]) At(i int) (T, error) {
+	if i < 0 || i >= len(h.heap) {
+		var zero T
+		return zero, ErrHeapOverflow
+	}
+
+	return h.heap[i], nil
+}
+
+// Set replaces the element value at index i of the heap, and restores the
+// heap property. If the index is out of range, an error is returned.
+func (h *Heap[T]) Set(i int, val T) error {
+	if i < 0 || i >= len(h.heap) {
+		return ErrHeapOverflow
+	}
+
+	h.heap[i] = val
+	return h.Fix(i)
+}
+
+// up moves the element at index i up the heap, by swapping it with its parent
+// as long as it is less than the parent.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		p := (i - 1) >> 1
+		if !h.less(h.heap[i], h.heap[p]) {
+			break
+		}
+
+		h.heap[i], h.heap[p] = h.heap[p], h.heap[i]
+		i = p
+	}
+}
+
+// down moves the element at index i down the heap, by swapping it with its
+// least child as long as that child is less than the element. It reports
+// whether the element was moved.
+func (h *Heap[T]) down(i int) bool {
+	n := len(h.heap)
+	i0 := i
+	for {
+		least := i
+
+		l := (i << 1) + 1
+		if l < n && h.less(h.heap[l], h.heap[least]) {
+			least = l
+		}
+
+		r := (i << 1) + 2
+		if r < n && h.less(h.heap[r], h.heap[least]) {
+			least = r
+		}
+
+		if least == i {
+			return i > i0
+		}
+
+		h.heap[i], h.heap[least] = h.heap[least], h.heap[i]
+		i = least
+	}
+}
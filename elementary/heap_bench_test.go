@@ -0,0 +1,272 @@
+package elementary_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+type edge struct {
+	to, w int
+}
+
+type item struct {
+	v, d int
+}
+
+func lessItem(a, b item) bool {
+	return a.d < b.d
+}
+
+// randomGraph returns a random directed graph with n vertices, each with deg
+// outgoing edges of random weight.
+func randomGraph(n, deg int) [][]edge {
+	r := rand.New(rand.NewSource(1))
+	g := make([][]edge, n)
+	for v := range g {
+		for range deg {
+			g[v] = append(g[v], edge{r.Intn(n), 1 + r.Intn(100)})
+		}
+	}
+
+	return g
+}
+
+func newDist(n int) []int {
+	dist := make([]int, n)
+	for v := range dist {
+		dist[v] = math.MaxInt
+	}
+
+	dist[0] = 0
+	return dist
+}
+
+// dijkstraLazy finds shortest paths from vertex 0 with a heap without
+// decrease-key, by pushing a vertex again whenever its distance improves, and
+// skipping stale entries when popped.
+func dijkstraLazy[T any](g [][]edge, h elementary.Heaper[T], enc func(item) T, dec func(T) item) []int {
+	dist := newDist(len(g))
+	h.Push(enc(item{0, 0}))
+
+	for !h.IsEmpty() {
+		t, _ := h.Pop()
+		it := dec(t)
+		if it.d > dist[it.v] {
+			continue
+		}
+
+		for _, e := range g[it.v] {
+			if d := it.d + e.w; d < dist[e.to] {
+				dist[e.to] = d
+				h.Push(enc(item{e.to, d}))
+			}
+		}
+	}
+
+	return dist
+}
+
+// decreaser defines a heap with decrease-key by element handles.
+type decreaser[E any] interface {
+	elementary.Heaper[item]
+	Insert(val item) E
+	DecreaseKey(e E, val item) error
+}
+
+// dijkstraDecrease finds shortest paths from vertex 0 with a heap supporting
+// decrease-key, keeping each vertex in the heap at most once.
+func dijkstraDecrease[E comparable](g [][]edge, h decreaser[E]) []int {
+	var none E
+	dist := newDist(len(g))
+	handles := make([]E, len(g))
+	handles[0] = h.Insert(item{0, 0})
+
+	for !h.IsEmpty() {
+		it, _ := h.Pop()
+		for _, e := range g[it.v] {
+			if d := it.d + e.w; d < dist[e.to] {
+				dist[e.to] = d
+				if handles[e.to] == none {
+					handles[e.to] = h.Insert(item{e.to, d})
+				} else {
+					h.DecreaseKey(handles[e.to], item{e.to, d})
+				}
+			}
+		}
+	}
+
+	return dist
+}
+
+// dijkstraIndexed finds shortest paths from vertex 0 with an indexed priority
+// queue.
+func dijkstraIndexed(g [][]edge) []int {
+	dist := newDist(len(g))
+	q := elementary.NewMinPriorityQueue[int, int]()
+	handles := make([]*elementary.PriorityQueueElement[int, int], len(g))
+	handles[0] = q.Insert(0, 0)
+
+	for !q.IsEmpty() {
+		x, _ := q.Pop()
+		for _, e := range g[x.Value] {
+			if d := x.Priority() + e.w; d < dist[e.to] {
+				dist[e.to] = d
+				if handles[e.to] == nil {
+					handles[e.to] = q.Insert(e.to, d)
+				} else {
+					q.UpdatePriority(handles[e.to], d)
+				}
+			}
+		}
+	}
+
+	return dist
+}
+
+func BenchmarkDijkstra(b *testing.B) {
+	const n = 5000
+
+	identity := func(it item) item { return it }
+	g := randomGraph(n, 16)
+	want := dijkstraLazy(g, elementary.NewHeap(lessItem), identity, identity)
+
+	benchmarks := map[string]func() []int{
+		"MaxHeap": func() []int {
+			// encode the negated distance and vertex in a single int
+			return dijkstraLazy[int](g, elementary.NewMaxHeap(),
+				func(it item) int { return -(it.d*n + it.v) },
+				func(x int) item { return item{-x % n, -x / n} },
+			)
+		},
+		"Heap": func() []int {
+			return dijkstraLazy(g, elementary.NewHeap(lessItem), identity, identity)
+		},
+		"PriorityQueue": func() []int {
+			return dijkstraIndexed(g)
+		},
+		"BinomialHeap": func() []int {
+			return dijkstraDecrease[*elementary.BinomialElement[item]](g,
+				elementary.NewBinomialHeap(lessItem))
+		},
+		"FibonacciHeap": func() []int {
+			return dijkstraDecrease[*elementary.FibonacciElement[item]](g,
+				elementary.NewFibonacciHeap(lessItem))
+		},
+		"PairingHeap": func() []int {
+			return dijkstraDecrease[*elementary.PairingElement[item]](g,
+				elementary.NewPairingHeap(lessItem))
+		},
+	}
+
+	for name, f := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			if got := f(); !slices.Equal(want, got) {
+				b.Fatal("shortest path distances differ")
+			}
+
+			b.ResetTimer()
+			for range b.N {
+				f()
+			}
+		})
+	}
+}
+
+func BenchmarkMeld(b *testing.B) {
+	const n = 1000
+
+	vals := rand.New(rand.NewSource(1)).Perm(2 * n)
+	less := func(a, b int) bool { return a < b }
+
+	b.Run("BinomialHeap", func(b *testing.B) {
+		for range b.N {
+			h, o := elementary.NewBinomialHeap(less), elementary.NewBinomialHeap(less)
+			for i := range n {
+				h.Push(vals[i])
+				o.Push(vals[n+i])
+			}
+
+			h.Meld(o)
+		}
+	})
+
+	b.Run("FibonacciHeap", func(b *testing.B) {
+		for range b.N {
+			h, o := elementary.NewFibonacciHeap(less), elementary.NewFibonacciHeap(less)
+			for i := range n {
+				h.Push(vals[i])
+				o.Push(vals[n+i])
+			}
+
+			h.Meld(o)
+		}
+	})
+
+	b.Run("PairingHeap", func(b *testing.B) {
+		for range b.N {
+			h, o := elementary.NewPairingHeap(less), elementary.NewPairingHeap(less)
+			for i := range n {
+				h.Push(vals[i])
+				o.Push(vals[n+i])
+			}
+
+			h.Meld(o)
+		}
+	})
+}
+
+func BenchmarkDaryHeap(b *testing.B) {
+	const n = 100000
+
+	less := func(a, b int) bool { return a < b }
+	vals := rand.New(rand.NewSource(1)).Perm(n)
+
+	identity := func(it item) item { return it }
+	g := randomGraph(5000, 16)
+	want := dijkstraLazy(g, elementary.NewHeap(lessItem), identity, identity)
+
+	for _, d := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("d=%d/PushPop", d), func(b *testing.B) {
+			for range b.N {
+				h, _ := elementary.NewDaryHeap(d, less)
+				for _, v := range vals {
+					h.Push(v)
+				}
+
+				for !h.IsEmpty() {
+					h.Pop()
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("d=%d/BuildHeap", d), func(b *testing.B) {
+			vs := make([]int, n)
+			for range b.N {
+				copy(vs, vals)
+				h, _ := elementary.NewDaryHeap(d, less)
+				h.BuildHeap(vs)
+			}
+		})
+
+		b.Run(fmt.Sprintf("d=%d/Dijkstra", d), func(b *testing.B) {
+			f := func() []int {
+				h, _ := elementary.NewDaryHeap(d, lessItem)
+				return dijkstraLazy(g, h, identity, identity)
+			}
+
+			if got := f(); !slices.Equal(want, got) {
+				b.Fatal("shortest path distances differ")
+			}
+
+			b.ResetTimer()
+			for range b.N {
+				f()
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// popAll pops every value of a heap in order.
+func popAll[T any](h elementary.Heaper[T]) []T {
+	var vals []T
+	for !h.IsEmpty() {
+		v, _ := h.Pop()
+		vals = append(vals, v)
+	}
+
+	return vals
+}
+
+func TestHeap(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		less func(a, b int) bool
+		vals []int
+		want []int
+	}{
+		"should pop nothing from an empty heap": {
+			less: func(a, b int) bool { return a < b },
+		},
+		"should pop values in increasing order": {
+			less: func(a, b int) bool { return a < b },
+			vals: []int{5, -1, 3, 3, 0, 8},
+			want: []int{-1, 0, 3, 3, 5, 8},
+		},
+		"should pop values in decreasing order": {
+			less: func(a, b int) bool { return a > b },
+			vals: []int{5, -1, 3, 3, 0, 8},
+			want: []int{8, 5, 3, 3, 0, -1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := elementary.NewHeap(test.less)
+			for _, v := range test.vals {
+				h.Push(v)
+			}
+
+			assert.Equal(len(test.vals), h.Size())
+			top, err := h.Peek()
+			if len(test.want) > 0 {
+				assert.NoError(err)
+				assert.Equal(test.want[0], top)
+			} else {
+				assert.Equal(elementary.ErrHeapUnderflow, err)
+			}
+
+			assert.Equal(test.want, popAll[int](h))
+
+			_, err = h.Pop()
+			assert.Equal(elementary.ErrHeapUnderflow, err)
+		})
+	}
+}
+
+func TestHeapOfStruct(t *testing.T) {
+	assert := assert.New(t)
+	type task struct {
+		name     string
+		priority int
+	}
+
+	h := elementary.NewHeap(func(a, b task) bool {
+		return a.priority < b.priority
+	})
+	h.Push(task{"write", 2})
+	h.Push(task{"read", 1})
+	h.Push(task{"exec", 3})
+
+	var got []string
+	for _, tk := range popAll[task](h) {
+		got = append(got, tk.name)
+	}
+	assert.Equal([]string{"read", "write", "exec"}, got)
+}
+
+// assertHeap asserts that no element of a heap is less than its parent.
+func assertHeap[T any](t *testing.T, h *elementary.Heap[T], less func(a, b T) bool) {
+	t.Helper()
+	for i := 1; i < h.Size(); i++ {
+		v, _ := h.At(i)
+		p, _ := h.At((i - 1) / 2)
+		assert.False(t, less(v, p), "element %d is less than its parent", i)
+	}
+}
+
+func TestNewHeapFromSlice(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []int
+		want []int
+	}{
+		"should build an empty heap": {},
+		"should build a heap of a single value": {
+			vals: []int{4},
+			want: []int{4},
+		},
+		"should build a heap with duplicates and negative values": {
+			vals: []int{9, -4, 7, 7, 0, -4, 12, 3},
+			want: []int{-4, -4, 0, 3, 7, 7, 9, 12},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			less := func(a, b int) bool { return a < b }
+			h := elementary.NewHeapFromSlice(test.vals, less)
+			assert.Equal(len(test.vals), h.Size())
+			assertHeap(t, h, less)
+			assert.Equal(test.want, popAll[int](h))
+
+			// BuildHeap replaces the current elements
+			h.Push(100)
+			h.BuildHeap([]int{2, 1})
+			assert.Equal([]int{1, 2}, popAll[int](h))
+		})
+	}
+}
+
+func TestHeapRemove(t *testing.T) {
+	assert := assert.New(t)
+	vals := []int{1, 5, 2, 8, 6, 3, 4, 9}
+	tests := map[string]struct {
+		index int
+		err   error
+	}{
+		"should remove the first element": {
+			index: 0,
+		},
+		"should remove a middle element": {
+			index: 3,
+		},
+		"should remove the last element": {
+			index: len(vals) - 1,
+		},
+		"should return error for a negative index": {
+			index: -1,
+			err:   elementary.ErrHeapOverflow,
+		},
+		"should return error for an index past the end": {
+			index: len(vals),
+			err:   elementary.ErrHeapOverflow,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := elementary.NewMinHeapOf[int]()
+			for _, v := range vals {
+				h.Push(v)
+			}
+
+			want, _ := h.At(test.index)
+			got, err := h.Remove(test.index)
+			assert.Equal(test.err, err)
+			if err != nil {
+				assert.Equal(len(vals), h.Size())
+				return
+			}
+
+			assert.Equal(want, got)
+			assert.Equal(len(vals)-1, h.Size())
+			assertHeap(t, h, func(a, b int) bool { return a < b })
+		})
+	}
+}
+
+func TestHeapFix(t *testing.T) {
+	assert := assert.New(t)
+	type item struct {
+		key int
+	}
+
+	less := func(a, b *item) bool { return a.key < b.key }
+	tests := map[string]struct {
+		index int
+		key   int
+		err   error
+	}{
+		"should move the first element down": {
+			index: 0,
+			key:   100,
+		},
+		"should move a middle element up": {
+			index: 3,
+			key:   -100,
+		},
+		"should move a middle element down": {
+			index: 1,
+			key:   100,
+		},
+		"should move the last element up": {
+			index: 7,
+			key:   -100,
+		},
+		"should return error for an index out of range": {
+			index: 8,
+			err:   elementary.ErrHeapOverflow,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := elementary.NewHeap(less)
+			for _, k := range []int{1, 5, 2, 8, 6, 3, 4, 9} {
+				h.Push(&item{k})
+			}
+
+			if e, err := h.At(test.index); err == nil {
+				e.key = test.key
+			}
+
+			assert.Equal(test.err, h.Fix(test.index))
+			assertHeap(t, h, less)
+			if test.err == nil && test.key < 0 {
+				top, _ := h.Peek()
+				assert.Equal(test.key, top.key)
+			}
+		})
+	}
+}
+
+func TestHeapAtSet(t *testing.T) {
+	assert := assert.New(t)
+	less := func(a, b int) bool { return a > b }
+	h := elementary.NewMaxHeapOf[int]()
+	for _, v := range []int{3, 1, 4, 1, 5} {
+		h.Push(v)
+	}
+
+	top, err := h.At(0)
+	assert.NoError(err)
+	assert.Equal(5, top)
+
+	_, err = h.At(5)
+	assert.Equal(elementary.ErrHeapOverflow, err)
+	assert.Equal(elementary.ErrHeapOverflow, h.Set(-1, 0))
+
+	// lowering the top moves it down
+	assert.NoError(h.Set(0, 0))
+	assertHeap(t, h, less)
+	top, _ = h.Peek()
+	assert.Equal(4, top)
+
+	// raising the last element moves it up
+	assert.NoError(h.Set(h.Size()-1, 10))
+	assertHeap(t, h, less)
+	top, _ = h.Peek()
+	assert.Equal(10, top)
+
+	assert.Equal(5, h.Size())
+}
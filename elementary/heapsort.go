@@ -0,0 +1,33 @@
+package elementary
+
+// HeapSort sorts a slice of int values in ascending order, in place. The slice
+// is first built into a max heap, after which the maximum value at the root is
+// repeatedly swapped with the last value of the heap. The heap is then shrunk
+// by one, leaving the maximum value in its final position, and the max heap
+// property is restored by heapifying the root. HeapSort runs in O(n lg n) time
+// and uses no extra storage, but it is not stable.
+func HeapSort(vals []int) {
+	h := NewMaxHeap()
+	h.BuildHeap(vals)
+
+	for i := len(vals) - 1; i > 0; i-- {
+		vals[0], vals[i] = vals[i], vals[0]
+		h.size--
+		h.Heapify(0)
+	}
+}
+
+// HeapSortFunc sorts a slice of values of any type in ascending order, as
+// given by a less function, in place. It works like HeapSort, with a heap
+// where the greatest value according to less is at the root.
+func HeapSortFunc[T any](vals []T, less func(a, b T) bool) {
+	h := NewHeapFromSlice(vals, func(a, b T) bool {
+		return less(b, a)
+	})
+
+	for i := len(vals) - 1; i > 0; i-- {
+		vals[0], vals[i] = vals[i], vals[0]
+		h.heap = h.heap[:i]
+		h.down(0)
+	}
+}
@@ -0,0 +1,78 @@
+package elementary_test
+
+import (
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestHeapSort(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []int
+		want []int
+	}{
+		"should sort an empty slice": {
+			vals: []int{},
+			want: []int{},
+		},
+		"should sort a single value": {
+			vals: []int{3},
+			want: []int{3},
+		},
+		"should sort values with duplicates and negatives": {
+			vals: []int{4, -2, 9, 4, 0, -2, -11, 7, 4},
+			want: []int{-11, -2, -2, 0, 4, 4, 4, 7, 9},
+		},
+		"should sort values in descending order": {
+			vals: []int{5, 4, 3, 2, 1},
+			want: []int{1, 2, 3, 4, 5},
+		},
+		"should keep sorted values": {
+			vals: []int{1, 2, 2, 3},
+			want: []int{1, 2, 2, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			vals := slices.Clone(test.vals)
+			elementary.HeapSort(vals)
+			assert.Equal(test.want, vals)
+
+			vals = slices.Clone(test.vals)
+			elementary.HeapSortFunc(vals, func(a, b int) bool { return a < b })
+			assert.Equal(test.want, vals)
+		})
+	}
+}
+
+func TestHeapSortFunc(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+
+	vals := make([]int, 500)
+	for i := range vals {
+		vals[i] = r.Intn(100) - 50
+	}
+
+	want := slices.Clone(vals)
+	slices.Sort(want)
+	slices.Reverse(want)
+
+	elementary.HeapSortFunc(vals, func(a, b int) bool { return a > b })
+	assert.Equal(want, vals)
+
+	words := []string{"pear", "Apple", "fig", "apple", "Fig"}
+	elementary.HeapSortFunc(words, func(a, b string) bool {
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+	for i := 1; i < len(words); i++ {
+		assert.LessOrEqual(strings.ToLower(words[i-1]), strings.ToLower(words[i]))
+	}
+}
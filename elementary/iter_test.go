@@ -0,0 +1,162 @@
+package elementary_test
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// take ranges over a sequence, and returns its first n values, or all of them
+// if n is negative. The range is stopped early once n values are taken.
+func take[T any](seq iter.Seq[T], n int) []T {
+	vals := []T{}
+	if n == 0 {
+		return vals
+	}
+
+	for v := range seq {
+		vals = append(vals, v)
+		if len(vals) == n {
+			break
+		}
+	}
+
+	return vals
+}
+
+// keys returns a sequence of the keys of a sequence of pairs.
+func keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// values returns a sequence of the values of a sequence of pairs.
+func values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestIterators(t *testing.T) {
+	assert := assert.New(t)
+
+	s := elementary.NewStackOf[int]()
+	q := elementary.NewQueueOf[int]()
+	ds := elementary.NewDSQueue()
+	l := elementary.NewListOf[int, int]()
+	for _, v := range []int{1, 2, 3, 4} {
+		s.Push(v)
+		q.Enqueue(v)
+		ds.Enqueue(v)
+		l.PushBack(v, v*10)
+	}
+
+	// split the elements of the DSQueue between both of its stacks
+	ds.Dequeue()
+	ds.Enqueue(5)
+
+	h := elementary.NewMaxHeap()
+	h.BuildHeap([]int{3, 1, 4, 1, 5})
+
+	tests := map[string]struct {
+		seq  iter.Seq[int]
+		want []int
+	}{
+		"should range over an empty stack": {
+			seq:  elementary.NewStackOf[int]().All(),
+			want: []int{},
+		},
+		"should range over a stack from top to bottom": {
+			seq:  s.All(),
+			want: []int{4, 3, 2, 1},
+		},
+		"should range over a stack from bottom to top": {
+			seq:  s.Backward(),
+			want: []int{1, 2, 3, 4},
+		},
+		"should range over an empty queue": {
+			seq:  elementary.NewQueueOf[int]().Backward(),
+			want: []int{},
+		},
+		"should range over a queue from head to tail": {
+			seq:  q.All(),
+			want: []int{1, 2, 3, 4},
+		},
+		"should range over a queue from tail to head": {
+			seq:  q.Backward(),
+			want: []int{4, 3, 2, 1},
+		},
+		"should range over an empty DSQueue": {
+			seq:  elementary.NewDSQueue().All(),
+			want: []int{},
+		},
+		"should range over a DSQueue from head to tail": {
+			seq:  ds.All(),
+			want: []int{2, 3, 4, 5},
+		},
+		"should range over a DSQueue from tail to head": {
+			seq:  ds.Backward(),
+			want: []int{5, 4, 3, 2},
+		},
+		"should range over an empty list": {
+			seq:  keys(elementary.NewListOf[int, int]().All()),
+			want: []int{},
+		},
+		"should range over the keys of a list from head to tail": {
+			seq:  keys(l.All()),
+			want: []int{1, 2, 3, 4},
+		},
+		"should range over the values of a list from head to tail": {
+			seq:  values(l.All()),
+			want: []int{10, 20, 30, 40},
+		},
+		"should range over the keys of a list from tail to head": {
+			seq:  keys(l.Backward()),
+			want: []int{4, 3, 2, 1},
+		},
+		"should range over an empty max heap": {
+			seq:  values(elementary.NewMaxHeap().All()),
+			want: []int{},
+		},
+		"should range over the indices of a max heap": {
+			seq:  keys(h.All()),
+			want: []int{0, 1, 2, 3, 4},
+		},
+		"should range over a max heap in the order of storage": {
+			seq:  values(h.All()),
+			want: []int{5, 3, 4, 1, 1},
+		},
+		"should range over the indices of a max heap backwards": {
+			seq:  keys(h.Backward()),
+			want: []int{4, 3, 2, 1, 0},
+		},
+		"should range over a max heap in the reverse order of storage": {
+			seq:  values(h.Backward()),
+			want: []int{1, 1, 4, 3, 5},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.want, take(test.seq, -1))
+
+			// stop early, after the first two values
+			assert.Equal(test.want[:min(2, len(test.want))], take(test.seq, 2))
+
+			// the same sequence can be ranged over again
+			assert.Equal(test.want, take(test.seq, -1))
+		})
+	}
+}
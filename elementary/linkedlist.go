@@ -2,76 +2,276 @@ package elementary
 
 import (
 	"errors"
+	"iter"
 )
 
 // Various errors a list function can return.
 var (
-	ErrDeleteSentinel = errors.New("cannot delete sentinel of list")
+	ErrDeleteSentinel   = errors.New("cannot delete sentinel of list")
+	ErrElementNotInList = errors.New("element is not in the list")
 )
 
-// NewLinkedList creates a new instance of a linked list data structure, which
-// is just an arrangement of elements in a linear order. The list is doubly
-// linked, i.e. each element has a pointer to its next and previous elements,
-// thereby providing a simple, but flexible representation of a dynamic set.
-// Further, the list contains a dummy element, called the sentinel. The sentinel
-// always lies between the head and the tail of the list - its previous pointer
-// will point the tail, while the tail's next pointer points to the sentinel,
-// thereby providing a circular, doubly linked list. This allows for simplifying
-// each list operation's boundary conditions, but adds an extra element, thus
-// adding to increased memory usage.
+// NewLinkedList creates a new instance of a linked list data structure with
+// string keys and int values. See NewListOf for a list of any key and value
+// type.
 func NewLinkedList() *List {
+	return NewListOf[string, int]()
+}
+
+// NewListOf creates a new instance of a linked list data structure, which is
+// just an arrangement of elements in a linear order. The list is doubly linked,
+// i.e. each element has a pointer to its next and previous elements, thereby
+// providing a simple, but flexible representation of a dynamic set. Further,
+// the list contains a dummy element, called the sentinel. The sentinel always
+// lies between the head and the tail of the list - its previous pointer will
+// point the tail, while the tail's next pointer points to the sentinel, thereby
+// providing a circular, doubly linked list. This allows for simplifying each
+// list operation's boundary conditions, but adds an extra element, thus adding
+// to increased memory usage.
+func NewListOf[K comparable, V any]() *ListOf[K, V] {
 	// create sentinel value
-	s := &ListElement{
-		Key:   "",
-		Value: 0,
-	}
+	s := &ListElementOf[K, V]{sentinel: true}
 	s.next = s
 	s.prev = s
 
 	// insert sentinel into the list
-	return &List{
+	return &ListOf[K, V]{
 		sent: s,
 	}
 }
 
-// List defines a queue structure with a head and tail element and a count of
-// the elements in it.
-type List struct {
-	sent *ListElement
+// List defines a list with string keys and int values.
+type List = ListOf[string, int]
+
+// ListElement defines an element of a list with string keys and int values.
+type ListElement = ListElementOf[string, int]
+
+// ListOf defines a list structure with a sentinel element and a count of the
+// elements in it.
+type ListOf[K comparable, V any] struct {
+	sent  *ListElementOf[K, V]
+	count int
+}
+
+// ListElementOf defines an element of the list, with a pointer to the list it
+// belongs to, which is nil once the element is deleted.
+type ListElementOf[K comparable, V any] struct {
+	next     *ListElementOf[K, V]
+	prev     *ListElementOf[K, V]
+	list     *ListOf[K, V]
+	sentinel bool
+	Key      K
+	Value    V
 }
 
-// ListElement defines an element of the list.
-type ListElement struct {
-	next  *ListElement
-	prev  *ListElement
-	Key   string
-	Value int
+// Next returns the next element of the list, or nil if the element is the
+// tail of the list.
+func (el *ListElementOf[K, V]) Next() *ListElementOf[K, V] {
+	if el.next == nil || el.next.sentinel {
+		return nil
+	}
+
+	return el.next
 }
 
-// IsEmpty checks if the list is empty, by comparing the sentinel's next and
-// previous values.
-func (l *List) IsEmpty() bool {
-	return l.sent.next == l.sent.prev
+// Prev returns the previous element of the list, or nil if the element is the
+// head of the list.
+func (el *ListElementOf[K, V]) Prev() *ListElementOf[K, V] {
+	if el.prev == nil || el.prev.sentinel {
+		return nil
+	}
+
+	return el.prev
+}
+
+// IsEmpty checks if the list is empty, i.e. if the sentinel's next element is
+// the sentinel itself.
+func (l *ListOf[K, V]) IsEmpty() bool {
+	return l.sent.next == l.sent
+}
+
+// Len returns the total number of elements in the list.
+func (l *ListOf[K, V]) Len() int {
+	return l.count
+}
+
+// Front returns the head element of the list, or nil if the list is empty.
+func (l *ListOf[K, V]) Front() *ListElementOf[K, V] {
+	return l.sent.Next()
+}
+
+// Back returns the tail element of the list, or nil if the list is empty.
+func (l *ListOf[K, V]) Back() *ListElementOf[K, V] {
+	return l.sent.Prev()
 }
 
 // Insert adds a new element with a given value to the list, by inserting it
 // right after the sentinel.
-func (l *List) Insert(key string, val int) {
-	el := &ListElement{
-		next:  l.sent.next,
-		prev:  l.sent,
-		Key:   key,
-		Value: val,
+func (l *ListOf[K, V]) Insert(key K, val V) {
+	l.PushFront(key, val)
+}
+
+// PushFront adds a new element with a given key and value to the head of the
+// list, i.e. right after the sentinel, and returns it.
+func (l *ListOf[K, V]) PushFront(key K, val V) *ListElementOf[K, V] {
+	return l.insert(&ListElementOf[K, V]{Key: key, Value: val}, l.sent)
+}
+
+// PushBack adds a new element with a given key and value to the tail of the
+// list, i.e. right before the sentinel, and returns it.
+func (l *ListOf[K, V]) PushBack(key K, val V) *ListElementOf[K, V] {
+	return l.insert(&ListElementOf[K, V]{Key: key, Value: val}, l.sent.prev)
+}
+
+// InsertBefore adds a new element with a given key and value right before a
+// given element of the list, and returns it. If the given element is not in the
+// list, an error is returned.
+func (l *ListOf[K, V]) InsertBefore(key K, val V, mark *ListElementOf[K, V]) (*ListElementOf[K, V], error) {
+	if mark.list != l || mark.sentinel {
+		return nil, ErrElementNotInList
+	}
+
+	return l.insert(&ListElementOf[K, V]{Key: key, Value: val}, mark.prev), nil
+}
+
+// InsertAfter adds a new element with a given key and value right after a
+// given element of the list, and returns it. If the given element is not in the
+// list, an error is returned.
+func (l *ListOf[K, V]) InsertAfter(key K, val V, mark *ListElementOf[K, V]) (*ListElementOf[K, V], error) {
+	if mark.list != l || mark.sentinel {
+		return nil, ErrElementNotInList
+	}
+
+	return l.insert(&ListElementOf[K, V]{Key: key, Value: val}, mark), nil
+}
+
+// MoveToFront moves a given element of the list to the head of the list. If
+// the element is not in the list, an error is returned.
+func (l *ListOf[K, V]) MoveToFront(el *ListElementOf[K, V]) error {
+	if el.list != l || el.sentinel {
+		return ErrElementNotInList
+	}
+
+	if l.sent.next != el {
+		l.unlink(el)
+		l.link(el, l.sent)
+	}
+
+	return nil
+}
+
+// MoveToBack moves a given element of the list to the tail of the list. If
+// the element is not in the list, an error is returned.
+func (l *ListOf[K, V]) MoveToBack(el *ListElementOf[K, V]) error {
+	if el.list != l || el.sentinel {
+		return ErrElementNotInList
+	}
+
+	if l.sent.prev != el {
+		l.unlink(el)
+		l.link(el, l.sent.prev)
+	}
+
+	return nil
+}
+
+// Splice moves all elements of list o to the tail of the list, leaving o
+// empty. The elements of o are relinked as a whole, but as each of them refers
+// to the list it belongs to, Splice is done in O(m) time, where m is the
+// number of elements of o.
+func (l *ListOf[K, V]) Splice(o *ListOf[K, V]) {
+	if l == o || o.IsEmpty() {
+		return
+	}
+
+	first, last := o.sent.next, o.sent.prev
+	for el := first; el != o.sent; el = el.next {
+		el.list = l
+	}
+
+	first.prev = l.sent.prev
+	l.sent.prev.next = first
+	last.next = l.sent
+	l.sent.prev = last
+	l.count += o.count
+
+	o.sent.next = o.sent
+	o.sent.prev = o.sent
+	o.count = 0
+}
+
+// Reverse reverses the order of the elements of the list in place, by swapping
+// the next and previous pointers of each element, including the sentinel.
+func (l *ListOf[K, V]) Reverse() {
+	el := l.sent
+	for {
+		el.next, el.prev = el.prev, el.next
+		el = el.prev
+		if el == l.sent {
+			return
+		}
+	}
+}
+
+// Sort sorts the elements of the list according to a less function, using a
+// bottom-up merge sort. The list is viewed as a sequence of sorted runs of
+// length 1, and in each pass, pairs of consecutive runs are merged into runs
+// of twice the length, until a single run remains. As the elements are merely
+// relinked, no extra storage is needed, and the sort runs in O(n lg n) time.
+// The sort is stable, i.e. elements that are equal keep their order.
+func (l *ListOf[K, V]) Sort(less func(a, b *ListElementOf[K, V]) bool) {
+	if l.count < 2 {
+		return
+	}
+
+	// detach the elements as a singly linked list
+	head := l.sent.next
+	l.sent.prev.next = nil
+
+	for size := 1; ; size <<= 1 {
+		var (
+			merged *ListElementOf[K, V]
+			tail   *ListElementOf[K, V]
+			merges int
+		)
+
+		for p := head; p != nil; merges++ {
+			// split off two runs a and b of at most size elements
+			a := p
+			b := splitRun(a, size)
+			p = splitRun(b, size)
+
+			first, last := mergeRuns(a, b, less)
+			if tail == nil {
+				merged = first
+			} else {
+				tail.next = first
+			}
+
+			tail = last
+		}
+
+		head = merged
+		if merges <= 1 {
+			break
+		}
 	}
 
-	// insert element between sentinel and the currently next element.
-	l.sent.next.prev = el
-	l.sent.next = el
+	// restore the previous pointers and the sentinel
+	prev := l.sent
+	for el := head; el != nil; el = el.next {
+		el.prev = prev
+		prev.next = el
+		prev = el
+	}
+
+	prev.next = l.sent
+	l.sent.prev = prev
 }
 
 // Search searches for an element with a given key by iteratively checking the
 // next element of the list.
-func (l *List) Search(key string) (*ListElement, bool) {
+func (l *ListOf[K, V]) Search(key K) (*ListElementOf[K, V], bool) {
 	el := l.sent.next
 	for el != l.sent {
 		if el.Key == key {
@@ -83,24 +283,136 @@ func (l *List) Search(key string) (*ListElement, bool) {
 	return nil, false
 }
 
-// Delete removes a given element from the list.
-func (l *List) Delete(el *ListElement) error {
-	if el == l.sent {
+// Delete removes a given element from the list. If the element is not in the
+// list, an error is returned.
+func (l *ListOf[K, V]) Delete(el *ListElementOf[K, V]) error {
+	if el.sentinel {
 		return ErrDeleteSentinel
 	}
 
-	el.prev.next = el.next
-	el.next.prev = el.prev
+	if el.list != l {
+		return ErrElementNotInList
+	}
+
+	l.unlink(el)
+	el.next = nil
+	el.prev = nil
+	el.list = nil
+	l.count--
 
 	return nil
 }
 
 // Traverse loops through each element in the list until it reaches the
-// sentinel.
-func (l *List) Traverse(f func(*ListElement)) {
+// sentinel. The next element is read before f is called, so f may delete the
+// element it is given.
+func (l *ListOf[K, V]) Traverse(f func(*ListElementOf[K, V])) {
 	el := l.sent.next
 	for el != l.sent {
+		next := el.next
 		f(el)
+		el = next
+	}
+}
+
+// All returns an iterator over the keys and values of the elements in the list
+// from head to tail. As with Traverse, the current element may be deleted
+// during iteration.
+func (l *ListOf[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for el := l.sent.next; el != l.sent; {
+			next := el.next
+			if !yield(el.Key, el.Value) {
+				return
+			}
+			el = next
+		}
+	}
+}
+
+// Backward returns an iterator over the keys and values of the elements in the
+// list from tail to head. The current element may be deleted during iteration.
+func (l *ListOf[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for el := l.sent.prev; el != l.sent; {
+			prev := el.prev
+			if !yield(el.Key, el.Value) {
+				return
+			}
+			el = prev
+		}
+	}
+}
+
+// insert links a new element right after a given element of the list, and
+// returns it.
+func (l *ListOf[K, V]) insert(el, at *ListElementOf[K, V]) *ListElementOf[K, V] {
+	el.list = l
+	l.link(el, at)
+	l.count++
+
+	return el
+}
+
+// link links an element right after a given element of the list.
+func (l *ListOf[K, V]) link(el, at *ListElementOf[K, V]) {
+	el.prev = at
+	el.next = at.next
+	at.next.prev = el
+	at.next = el
+}
+
+// unlink unlinks an element from its neighbours in the list.
+func (l *ListOf[K, V]) unlink(el *ListElementOf[K, V]) {
+	el.prev.next = el.next
+	el.next.prev = el.prev
+}
+
+// splitRun cuts a singly linked list after at most n elements, and returns the
+// rest of the list.
+func splitRun[K comparable, V any](el *ListElementOf[K, V], n int) *ListElementOf[K, V] {
+	for i := 1; el != nil && i < n; i++ {
 		el = el.next
 	}
+
+	if el == nil {
+		return nil
+	}
+
+	rest := el.next
+	el.next = nil
+
+	return rest
+}
+
+// mergeRuns merges two sorted singly linked lists into one, and returns its
+// first and last elements. Ties are resolved in favour of list a, which keeps
+// the merge stable.
+func mergeRuns[K comparable, V any](a, b *ListElementOf[K, V], less func(a, b *ListElementOf[K, V]) bool) (*ListElementOf[K, V], *ListElementOf[K, V]) {
+	var head ListElementOf[K, V]
+	tail := &head
+
+	for a != nil && b != nil {
+		if less(b, a) {
+			tail.next = b
+			b = b.next
+		} else {
+			tail.next = a
+			a = a.next
+		}
+
+		tail = tail.next
+	}
+
+	if a != nil {
+		tail.next = a
+	} else {
+		tail.next = b
+	}
+
+	for tail.next != nil {
+		tail = tail.next
+	}
+
+	return head.next, tail
 }
@@ -0,0 +1,289 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// listKeys returns the keys of a list from head to tail.
+func listKeys[K comparable, V any](l *elementary.ListOf[K, V]) []K {
+	var ks []K
+	l.Traverse(func(e *elementary.ListElementOf[K, V]) {
+		ks = append(ks, e.Key)
+	})
+
+	return ks
+}
+
+func TestLinkedList(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		keys   []string
+		search string
+		found  bool
+		want   []string
+	}{
+		"should not find a key in an empty list": {
+			search: "a",
+		},
+		"should find a key in a single element list": {
+			keys:   []string{"a"},
+			search: "a",
+			found:  true,
+		},
+		"should insert keys at the head and find one": {
+			keys:   []string{"a", "b", "c"},
+			search: "b",
+			found:  true,
+			want:   []string{"c", "a"},
+		},
+		"should not find a missing key": {
+			keys:   []string{"a", "b"},
+			search: "c",
+			want:   []string{"b", "a"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := elementary.NewLinkedList()
+			for i, k := range test.keys {
+				l.Insert(k, i)
+			}
+
+			assert.Equal(len(test.keys) == 0, l.IsEmpty())
+
+			e, found := l.Search(test.search)
+			assert.Equal(test.found, found)
+			if found {
+				assert.Equal(test.search, e.Key)
+				assert.NoError(l.Delete(e))
+				assert.Equal(elementary.ErrElementNotInList, l.Delete(e))
+			}
+
+			assert.Equal(test.want, listKeys(l))
+			assert.Equal(len(test.want) == 0, l.IsEmpty())
+		})
+	}
+}
+
+// newListOf creates a list of int keys, with the keys in the given order and
+// each value set to the position of its key.
+func newListOf(keys ...int) *elementary.ListOf[int, int] {
+	l := elementary.NewListOf[int, int]()
+	for i, k := range keys {
+		l.PushBack(k, i)
+	}
+
+	return l
+}
+
+func TestListLen(t *testing.T) {
+	assert := assert.New(t)
+	l := elementary.NewListOf[int, int]()
+	assert.Equal(0, l.Len())
+	assert.True(l.IsEmpty())
+	assert.Nil(l.Front())
+	assert.Nil(l.Back())
+
+	a := l.PushBack(1, 0)
+	b := l.PushFront(0, 0)
+	c, err := l.InsertAfter(2, 0, a)
+	assert.NoError(err)
+	_, err = l.InsertBefore(3, 0, c)
+	assert.NoError(err)
+	assert.Equal(4, l.Len())
+	assert.False(l.IsEmpty())
+	assert.Equal([]int{0, 1, 3, 2}, listKeys(l))
+	assert.Same(b, l.Front())
+	assert.Same(c, l.Back())
+
+	assert.NoError(l.MoveToBack(b))
+	assert.NoError(l.MoveToFront(c))
+	assert.Equal([]int{2, 1, 3, 0}, listKeys(l))
+	assert.Equal(4, l.Len())
+
+	for !l.IsEmpty() {
+		assert.NoError(l.Delete(l.Front()))
+	}
+	assert.Equal(0, l.Len())
+	assert.Nil(l.Front())
+}
+
+func TestListForeignElement(t *testing.T) {
+	assert := assert.New(t)
+	l := newListOf(1, 2)
+	o := newListOf(3)
+	x := o.Front()
+
+	_, err := l.InsertBefore(0, 0, x)
+	assert.Equal(elementary.ErrElementNotInList, err)
+	_, err = l.InsertAfter(0, 0, x)
+	assert.Equal(elementary.ErrElementNotInList, err)
+	assert.Equal(elementary.ErrElementNotInList, l.MoveToFront(x))
+	assert.Equal(elementary.ErrElementNotInList, l.MoveToBack(x))
+	assert.Equal(elementary.ErrElementNotInList, l.Delete(x))
+
+	// neither list is affected
+	assert.Equal([]int{1, 2}, listKeys(l))
+	assert.Equal([]int{3}, listKeys(o))
+	assert.Equal(2, l.Len())
+	assert.Equal(1, o.Len())
+
+	// a deleted element is in no list
+	assert.NoError(o.Delete(x))
+	assert.Equal(elementary.ErrElementNotInList, o.MoveToFront(x))
+	_, err = o.InsertAfter(0, 0, x)
+	assert.Equal(elementary.ErrElementNotInList, err)
+}
+
+func TestListSort(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		keys []int
+		want []int
+	}{
+		"should sort an empty list": {},
+		"should sort a single element": {
+			keys: []int{4},
+			want: []int{4},
+		},
+		"should sort keys with duplicates and negatives": {
+			keys: []int{5, -1, 3, 3, 0, 8, -7, 3, 12},
+			want: []int{-7, -1, 0, 3, 3, 3, 5, 8, 12},
+		},
+		"should sort keys in descending order": {
+			keys: []int{6, 5, 4, 3, 2, 1, 0},
+			want: []int{0, 1, 2, 3, 4, 5, 6},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newListOf(test.keys...)
+			l.Sort(func(a, b *elementary.ListElementOf[int, int]) bool {
+				return a.Key < b.Key
+			})
+
+			assert.Equal(test.want, listKeys(l))
+			assert.Equal(len(test.keys), l.Len())
+
+			// the previous pointers are restored
+			var back []int
+			for k := range l.Backward() {
+				back = append([]int{k}, back...)
+			}
+			assert.Equal(test.want, back)
+		})
+	}
+}
+
+func TestListSortStable(t *testing.T) {
+	assert := assert.New(t)
+
+	// the values record the original position of each key
+	l := newListOf(2, 1, 2, 0, 1, 2, 0, 1)
+	l.Sort(func(a, b *elementary.ListElementOf[int, int]) bool {
+		return a.Key < b.Key
+	})
+
+	var got [][2]int
+	for k, v := range l.All() {
+		got = append(got, [2]int{k, v})
+	}
+	assert.Equal([][2]int{
+		{0, 3}, {0, 6},
+		{1, 1}, {1, 4}, {1, 7},
+		{2, 0}, {2, 2}, {2, 5},
+	}, got)
+}
+
+func TestListReverse(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		keys []int
+		want []int
+	}{
+		"should reverse an empty list": {},
+		"should reverse a single element": {
+			keys: []int{1},
+			want: []int{1},
+		},
+		"should reverse a list of even length": {
+			keys: []int{1, 2, 3, 4},
+			want: []int{4, 3, 2, 1},
+		},
+		"should reverse a list of odd length": {
+			keys: []int{1, 2, 3},
+			want: []int{3, 2, 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newListOf(test.keys...)
+			l.Reverse()
+			assert.Equal(test.want, listKeys(l))
+			assert.Equal(len(test.keys), l.Len())
+
+			l.Reverse()
+			assert.Equal(test.keys, listKeys(l))
+		})
+	}
+}
+
+func TestListSplice(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		keys  []int
+		other []int
+		want  []int
+	}{
+		"should splice two empty lists": {},
+		"should splice an empty list": {
+			keys: []int{1, 2},
+			want: []int{1, 2},
+		},
+		"should splice into an empty list": {
+			other: []int{3, 4},
+			want:  []int{3, 4},
+		},
+		"should splice two lists": {
+			keys:  []int{1, 2},
+			other: []int{3, 4, 5},
+			want:  []int{1, 2, 3, 4, 5},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newListOf(test.keys...)
+			o := newListOf(test.other...)
+			moved := o.Front()
+
+			l.Splice(o)
+			assert.Equal(test.want, listKeys(l))
+			assert.Equal(len(test.want), l.Len())
+			assert.True(o.IsEmpty())
+			assert.Equal(0, o.Len())
+
+			// the moved elements belong to the list
+			if moved != nil {
+				assert.Equal(elementary.ErrElementNotInList, o.Delete(moved))
+				assert.NoError(l.MoveToFront(moved))
+				assert.Equal(test.other[0], l.Front().Key)
+			}
+
+			// the emptied list is reusable
+			o.PushBack(9, 0)
+			assert.Equal([]int{9}, listKeys(o))
+		})
+	}
+
+	l := newListOf(1, 2)
+	l.Splice(l)
+	assert.Equal([]int{1, 2}, listKeys(l))
+}
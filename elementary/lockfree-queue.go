@@ -0,0 +1,130 @@
+package elementary
+
+import "sync/atomic"
+
+// NewLockFreeQueue creates a new instance of a LockFreeQueue data structure.
+// It's a FIFO queue like Queue, which is safe for concurrent use by multiple
+// goroutines without locks, known as a Michael-Scott queue. The queue is a
+// singly linked list with atomic pointers to its head and tail, where the head
+// is always a dummy element, and the first element of the queue is the one
+// following it. An element is enqueued by swapping it in as the next element
+// of the tail with a compare-and-swap, after which the tail is swung to it. An
+// element is dequeued by swinging the head to its next element, which becomes
+// the new dummy element. Between the two swaps of an enqueue, the tail lags one
+// element behind. Any goroutine finding the tail lagging helps swinging it,
+// rather than waiting for the enqueueing goroutine, so no goroutine can block
+// another. As with LockFreeStack, a new element is allocated for every
+// enqueue, which rules out the ABA problem under garbage collection. Enqueue
+// and Dequeue are done in O(1) time without contention.
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	dummy := &lockFreeNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+
+	return q
+}
+
+// LockFreeQueue defines a lock-free queue structure with atomic pointers to
+// the head and tail elements and a count of the elements in it.
+type LockFreeQueue[T any] struct {
+	head  atomic.Pointer[lockFreeNode[T]]
+	tail  atomic.Pointer[lockFreeNode[T]]
+	count atomic.Int64
+}
+
+// lockFreeNode defines an element of the lock-free queue. The value is held by
+// an atomic pointer, so that it can be cleared once the element is dequeued
+// while other goroutines may still be reading it.
+type lockFreeNode[T any] struct {
+	next  atomic.Pointer[lockFreeNode[T]]
+	value atomic.Pointer[T]
+}
+
+// IsEmpty checks if the queue is empty.
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+// Enqueue adds an element value to the tail of the queue.
+func (q *LockFreeQueue[T]) Enqueue(val T) {
+	n := &lockFreeNode[T]{}
+	n.value.Store(&val)
+
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			// the tail is lagging, so help swinging it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			q.count.Add(1)
+			return
+		}
+	}
+}
+
+// Dequeue removes and returns the head element value of the queue, unless the
+// queue underflows.
+func (q *LockFreeQueue[T]) Dequeue() (T, error) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			var zero T
+			return zero, ErrQueueUnderflow
+		}
+
+		if head == tail {
+			// the tail is lagging, so help swinging it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// read the value before the swap, as next may be dequeued right after
+		val := next.value.Load()
+		if q.head.CompareAndSwap(head, next) {
+			// next is the new dummy element, which must not keep the value
+			// alive
+			next.value.Store(nil)
+			q.count.Add(-1)
+			return *val, nil
+		}
+	}
+}
+
+// Peek returns the head element value of the queue, unless the queue is empty.
+// The element is not dequeued.
+func (q *LockFreeQueue[T]) Peek() (T, error) {
+	for {
+		next := q.head.Load().next.Load()
+		if next == nil {
+			var zero T
+			return zero, ErrQueueUnderflow
+		}
+
+		// the value is cleared if next was dequeued meanwhile, so try again
+		if val := next.value.Load(); val != nil {
+			return *val, nil
+		}
+	}
+}
+
+// Size returns the total number of elements in the queue. As other goroutines
+// may enqueue or dequeue concurrently, the size may be outdated once returned.
+func (q *LockFreeQueue[T]) Size() int {
+	return max(0, int(q.count.Load()))
+}
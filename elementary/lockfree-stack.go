@@ -0,0 +1,94 @@
+package elementary
+
+import "sync/atomic"
+
+// NewLockFreeStack creates a new instance of a LockFreeStack data structure.
+// It's a LIFO stack like Stack, which is safe for concurrent use by multiple
+// goroutines without locks, known as a Treiber stack. The top of the stack is
+// an atomic pointer. An element is pushed by pointing it to the current top,
+// and swapping it in as the new top with a compare-and-swap, which only
+// succeeds if the top has not changed in the meantime. Otherwise, another
+// goroutine got there first, and the push is retried. Pop works the same way,
+// by swapping the top for its next element.
+//
+// A compare-and-swap on pointers is usually prone to the ABA problem, where the
+// top is popped and a new element is pushed at the same address between the
+// load and the swap, so the swap succeeds on a stale next pointer. As every
+// push allocates a new element, and the garbage collector never reuses the
+// memory of an element while any goroutine still holds a pointer to it, two
+// elements seen by a goroutine can never share an address, so the problem
+// cannot occur. Push and Pop are done in O(1) time without contention, and
+// no goroutine can block another.
+func NewLockFreeStack[T any]() *LockFreeStack[T] {
+	return &LockFreeStack[T]{}
+}
+
+// LockFreeStack defines a lock-free stack structure with an atomic pointer to
+// the top element and a count of the elements in it.
+type LockFreeStack[T any] struct {
+	top   atomic.Pointer[lockFreeElement[T]]
+	count atomic.Int64
+}
+
+// lockFreeElement defines an element of the lock-free stack. The next pointer
+// is set before the element is published, and never changed after.
+type lockFreeElement[T any] struct {
+	next  *lockFreeElement[T]
+	value T
+}
+
+// IsEmpty checks if the stack is empty.
+func (s *LockFreeStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// Push adds an element value to the top of the stack.
+func (s *LockFreeStack[T]) Push(val T) {
+	e := &lockFreeElement[T]{
+		value: val,
+	}
+
+	for {
+		top := s.top.Load()
+		e.next = top
+		if s.top.CompareAndSwap(top, e) {
+			s.count.Add(1)
+			return
+		}
+	}
+}
+
+// Pop removes and returns the top element value of the stack, unless the stack
+// underflows.
+func (s *LockFreeStack[T]) Pop() (T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, ErrStackUnderflow
+		}
+
+		if s.top.CompareAndSwap(top, top.next) {
+			s.count.Add(-1)
+			return top.value, nil
+		}
+	}
+}
+
+// Peek returns the top element value of the stack, unless the stack is empty.
+// The element is not popped.
+func (s *LockFreeStack[T]) Peek() (T, error) {
+	top := s.top.Load()
+	if top == nil {
+		var zero T
+		return zero, ErrStackUnderflow
+	}
+
+	return top.value, nil
+}
+
+// Size returns the total number of elements in the stack. As other goroutines
+// may push or pop concurrently, the size may be outdated once returned.
+func (s *LockFreeStack[T]) Size() int {
+	return max(0, int(s.count.Load()))
+}
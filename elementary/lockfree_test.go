@@ -0,0 +1,286 @@
+package elementary_test
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/madshov/data-structures/elementary"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	stressWorkers = 8
+	stressOps     = 2000
+)
+
+func TestLockFreeStack(t *testing.T) {
+	assert := assert.New(t)
+	s := elementary.NewLockFreeStack[int]()
+
+	assert.True(s.IsEmpty())
+	_, err := s.Pop()
+	assert.Equal(elementary.ErrStackUnderflow, err)
+	_, err = s.Peek()
+	assert.Equal(elementary.ErrStackUnderflow, err)
+
+	for i := range 3 {
+		s.Push(i)
+	}
+
+	assert.Equal(3, s.Size())
+	v, err := s.Peek()
+	assert.NoError(err)
+	assert.Equal(2, v)
+
+	for i := 2; i >= 0; i-- {
+		v, err := s.Pop()
+		assert.NoError(err)
+		assert.Equal(i, v)
+	}
+
+	assert.True(s.IsEmpty())
+}
+
+func TestLockFreeQueue(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewLockFreeQueue[int]()
+
+	assert.True(q.IsEmpty())
+	_, err := q.Dequeue()
+	assert.Equal(elementary.ErrQueueUnderflow, err)
+	_, err = q.Peek()
+	assert.Equal(elementary.ErrQueueUnderflow, err)
+
+	for i := range 3 {
+		q.Enqueue(i)
+	}
+
+	assert.Equal(3, q.Size())
+	v, err := q.Peek()
+	assert.NoError(err)
+	assert.Equal(0, v)
+
+	for i := range 3 {
+		v, err := q.Dequeue()
+		assert.NoError(err)
+		assert.Equal(i, v)
+	}
+
+	assert.True(q.IsEmpty())
+}
+
+func TestLockFreeQueueReleasesValue(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewLockFreeQueue[*[64]int]()
+	defer runtime.KeepAlive(q)
+
+	// the dequeued element stays in the queue as the dummy element, but must
+	// not keep its value from being collected
+	freed := make(chan struct{})
+	v := new([64]int)
+	runtime.SetFinalizer(v, func(*[64]int) { close(freed) })
+	q.Enqueue(v)
+	v = nil
+
+	got, err := q.Dequeue()
+	assert.NoError(err)
+	assert.NotNil(got)
+
+	for range 10 {
+		runtime.GC()
+		select {
+		case <-freed:
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	t.Error("dequeued value was not collected")
+}
+
+func TestLockFreeStackStress(t *testing.T) {
+	assert := assert.New(t)
+	s := elementary.NewLockFreeStack[int]()
+
+	var wg sync.WaitGroup
+	popped := make([][]int, stressWorkers)
+	for w := range stressWorkers {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			for i := range stressOps {
+				s.Push(w*stressOps + i)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for range stressOps {
+				if v, err := s.Pop(); err == nil {
+					popped[w] = append(popped[w], v)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// drain whatever the poppers did not get to
+	var rest []int
+	for v, err := s.Pop(); err == nil; v, err = s.Pop() {
+		rest = append(rest, v)
+	}
+
+	count := make([]int, stressWorkers*stressOps)
+	for _, vs := range append(popped, rest) {
+		for _, v := range vs {
+			count[v]++
+		}
+	}
+
+	for _, c := range count {
+		assert.Equal(1, c)
+	}
+
+	assert.Equal(0, s.Size())
+}
+
+func TestLockFreeQueueStress(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewLockFreeQueue[int]()
+
+	var wg sync.WaitGroup
+	dequeued := make([][]int, stressWorkers)
+	for w := range stressWorkers {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			for i := range stressOps {
+				q.Enqueue(w*stressOps + i)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+			for range stressOps {
+				if v, err := q.Dequeue(); err == nil {
+					dequeued[w] = append(dequeued[w], v)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	var rest []int
+	for v, err := q.Dequeue(); err == nil; v, err = q.Dequeue() {
+		rest = append(rest, v)
+	}
+
+	// every value is dequeued exactly once, and each consumer sees the values
+	// of each producer in the order they were enqueued
+	count := make([]int, stressWorkers*stressOps)
+	for _, vs := range append(dequeued, rest) {
+		last := make([]int, stressWorkers)
+		for p := range last {
+			last[p] = -1
+		}
+
+		for _, v := range vs {
+			count[v]++
+			p := v / stressOps
+			assert.Greater(v%stressOps, last[p])
+			last[p] = v % stressOps
+		}
+	}
+
+	for _, c := range count {
+		assert.Equal(1, c)
+	}
+
+	assert.Equal(0, q.Size())
+}
+
+// mutexStack wraps a StackOf with a mutex for comparison.
+type mutexStack struct {
+	mu sync.Mutex
+	s  *elementary.StackOf[int]
+}
+
+func (m *mutexStack) Push(v int) {
+	m.mu.Lock()
+	m.s.Push(v)
+	m.mu.Unlock()
+}
+
+func (m *mutexStack) Pop() {
+	m.mu.Lock()
+	m.s.Pop()
+	m.mu.Unlock()
+}
+
+// mutexQueue wraps a QueueOf with a mutex for comparison.
+type mutexQueue struct {
+	mu sync.Mutex
+	q  *elementary.QueueOf[int]
+}
+
+func (m *mutexQueue) Enqueue(v int) {
+	m.mu.Lock()
+	m.q.Enqueue(v)
+	m.mu.Unlock()
+}
+
+func (m *mutexQueue) Dequeue() {
+	m.mu.Lock()
+	m.q.Dequeue()
+	m.mu.Unlock()
+}
+
+func BenchmarkConcurrentStack(b *testing.B) {
+	b.Run("LockFree", func(b *testing.B) {
+		s := elementary.NewLockFreeStack[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				s.Push(i)
+				s.Pop()
+			}
+		})
+	})
+
+	b.Run("Mutex", func(b *testing.B) {
+		s := &mutexStack{s: elementary.NewStackOf[int]()}
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				s.Push(i)
+				s.Pop()
+			}
+		})
+	})
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+	b.Run("LockFree", func(b *testing.B) {
+		q := elementary.NewLockFreeQueue[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				q.Enqueue(i)
+				q.Dequeue()
+			}
+		})
+	})
+
+	b.Run("Mutex", func(b *testing.B) {
+		q := &mutexQueue{q: elementary.NewQueueOf[int]()}
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				q.Enqueue(i)
+				q.Dequeue()
+			}
+		})
+	})
+}
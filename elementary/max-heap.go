@@ -2,6 +2,8 @@ package elementary
 
 import (
 	"errors"
+	"iter"
+	"math"
 )
 
 // Various errors a heap function can return.
@@ -9,6 +11,7 @@ var (
 	ErrHeapOverflow  = errors.New("heap overflow")
 	ErrHeapUnderflow = errors.New("heap underflow")
 	ErrKeyMismatch   = errors.New("key is smaller than current")
+	ErrKeyOrder      = errors.New("key is ordered after current")
 )
 
 func NewMaxHeap() *MaxHeap {
@@ -134,7 +137,63 @@ func (h *MaxHeap) IncreaseVal(i, val int) error {
 // Insert adds an element value to the heap, and ensures the max heap property
 // is still satisfied.
 func (h *MaxHeap) Insert(val int) {
-	h.heap = append(h.heap, -1)
+	h.heap = append(h.heap[:h.size], math.MinInt)
 	h.size++
 	h.IncreaseVal(h.size-1, val)
 }
+
+// IsEmpty checks if the heap is empty.
+func (h *MaxHeap) IsEmpty() bool {
+	return h.size == 0
+}
+
+// Size returns the total number of elements in the heap.
+func (h *MaxHeap) Size() int {
+	return h.size
+}
+
+// Push adds an element value to the heap. It is the same as Insert.
+func (h *MaxHeap) Push(val int) {
+	h.Insert(val)
+}
+
+// Peek returns the maximum element value of the heap, unless the heap is
+// empty.
+func (h *MaxHeap) Peek() (int, error) {
+	if h.size < 1 {
+		return -1, ErrHeapUnderflow
+	}
+
+	return h.heap[0], nil
+}
+
+// Pop removes and returns the maximum element value of the heap. It is the
+// same as ExtractMax.
+func (h *MaxHeap) Pop() (int, error) {
+	return h.ExtractMax()
+}
+
+// All returns an iterator over the indices and element values of the heap in
+// the order they are stored, i.e. level by level from the root. The values are
+// not in sorted order.
+func (h *MaxHeap) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := 0; i < h.size; i++ {
+			if !yield(i, h.heap[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the indices and element values of the heap
+// in the reverse order they are stored.
+func (h *MaxHeap) Backward() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := h.size - 1; i >= 0; i-- {
+			if !yield(i, h.heap[i]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package elementary
+
+// cursor defines the current value of one of the sources of a k-way merge.
+type cursor[T any] struct {
+	val T
+	src int
+}
+
+// Merge merges a number of slices, each sorted in ascending order according to
+// a less function, into a single sorted slice. A min heap holds the current
+// value of each slice, so the least remaining value is always at the top. When
+// it is popped, the next value of the same slice takes its place. Merging k
+// slices with n values in total is done in O(n lg k) time. The merge is stable,
+// i.e. equal values keep the order of the slices they come from.
+func Merge[T any](less func(a, b T) bool, vals ...[]T) []T {
+	var n int
+	for _, vs := range vals {
+		n += len(vs)
+	}
+
+	h := NewHeap(cursorLess(less))
+	next := make([]int, len(vals))
+	for i, vs := range vals {
+		if len(vs) > 0 {
+			h.Push(cursor[T]{vs[0], i})
+			next[i] = 1
+		}
+	}
+
+	merged := make([]T, 0, n)
+	for !h.IsEmpty() {
+		c := h.heap[0]
+		merged = append(merged, c.val)
+
+		if vs := vals[c.src]; next[c.src] < len(vs) {
+			// replace the top rather than popping and pushing
+			h.heap[0] = cursor[T]{vs[next[c.src]], c.src}
+			next[c.src]++
+			h.down(0)
+		} else {
+			h.Pop()
+		}
+	}
+
+	return merged
+}
+
+// MergeChannels merges a number of channels, each delivering values in
+// ascending order according to a less function, into a single channel
+// delivering the values in sorted order, like Merge. As the least value can
+// only be determined once every channel has delivered a value or been closed,
+// the merge waits on all open channels before each value is sent. The returned
+// channel is closed once all the given channels are closed and drained. The
+// values must be received until then, or the merging goroutine will leak.
+func MergeChannels[T any](less func(a, b T) bool, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+
+	go func() {
+		defer close(out)
+
+		h := NewHeap(cursorLess(less))
+		for i, ch := range chans {
+			if v, ok := <-ch; ok {
+				h.Push(cursor[T]{v, i})
+			}
+		}
+
+		for !h.IsEmpty() {
+			c, _ := h.Pop()
+			out <- c.val
+
+			if v, ok := <-chans[c.src]; ok {
+				h.Push(cursor[T]{v, c.src})
+			}
+		}
+	}()
+
+	return out
+}
+
+// cursorLess returns a less function on cursors, ordering cursors of equal
+// values by their source.
+func cursorLess[T any](less func(a, b T) bool) func(a, b cursor[T]) bool {
+	return func(a, b cursor[T]) bool {
+		if less(a.val, b.val) {
+			return true
+		}
+
+		if less(b.val, a.val) {
+			return false
+		}
+
+		return a.src < b.src
+	}
+}
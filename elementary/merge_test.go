@@ -0,0 +1,110 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestMerge(t *testing.T) {
+	assert := assert.New(t)
+	less := func(a, b int) bool { return a < b }
+	tests := map[string]struct {
+		vals [][]int
+		want []int
+	}{
+		"should merge no slices": {
+			want: []int{},
+		},
+		"should merge empty slices": {
+			vals: [][]int{{}, nil, {}},
+			want: []int{},
+		},
+		"should merge a single slice": {
+			vals: [][]int{{1, 2, 2}},
+			want: []int{1, 2, 2},
+		},
+		"should merge slices of different lengths": {
+			vals: [][]int{{1, 4, 7}, {}, {-3, 2}, {0, 5, 6, 8, 9}},
+			want: []int{-3, 0, 1, 2, 4, 5, 6, 7, 8, 9},
+		},
+		"should merge slices with duplicates": {
+			vals: [][]int{{1, 1, 3}, {1, 3, 3}},
+			want: []int{1, 1, 1, 3, 3, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.want, elementary.Merge(less, test.vals...))
+		})
+	}
+}
+
+func TestMergeStable(t *testing.T) {
+	assert := assert.New(t)
+	type entry struct {
+		key int
+		src string
+	}
+
+	less := func(a, b entry) bool { return a.key < b.key }
+	got := elementary.Merge(less,
+		[]entry{{1, "a"}, {2, "a"}, {2, "a"}},
+		[]entry{{1, "b"}, {2, "b"}},
+		[]entry{{0, "c"}, {2, "c"}},
+	)
+
+	assert.Equal([]entry{
+		{0, "c"},
+		{1, "a"}, {1, "b"},
+		{2, "a"}, {2, "a"}, {2, "b"}, {2, "c"},
+	}, got)
+}
+
+func TestMergeChannels(t *testing.T) {
+	assert := assert.New(t)
+	less := func(a, b int) bool { return a < b }
+	tests := map[string]struct {
+		vals [][]int
+		want []int
+	}{
+		"should merge no channels": {},
+		"should merge closed channels": {
+			vals: [][]int{{}, {}},
+		},
+		"should merge channels of different lengths": {
+			vals: [][]int{{1, 4, 7}, {}, {-3, 2}, {0, 5, 6, 8, 9}},
+			want: []int{-3, 0, 1, 2, 4, 5, 6, 7, 8, 9},
+		},
+		"should merge channels with duplicates": {
+			vals: [][]int{{1, 1, 3}, {1, 3, 3}},
+			want: []int{1, 1, 1, 3, 3, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			chans := make([]<-chan int, len(test.vals))
+			for i, vs := range test.vals {
+				// unbuffered, so values are sent as the merge asks for them
+				ch := make(chan int)
+				go func() {
+					defer close(ch)
+					for _, v := range vs {
+						ch <- v
+					}
+				}()
+				chans[i] = ch
+			}
+
+			var got []int
+			for v := range elementary.MergeChannels(less, chans...) {
+				got = append(got, v)
+			}
+			assert.Equal(test.want, got)
+		})
+	}
+}
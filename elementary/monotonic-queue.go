@@ -0,0 +1,109 @@
+package elementary
+
+import "cmp"
+
+// NewMonotonicQueue creates a new instance of a monotonic queue, where the
+// ordering is given by a less function. A monotonic queue is a FIFO queue,
+// which only keeps the values that may still become the least value of the
+// queue according to less. When a value is pushed, every value at the tail of
+// the queue that is not less than it is dropped, as it was pushed earlier and
+// thus leaves the queue first, so it can never be the least value again. The
+// values kept are therefore in increasing order from head to tail, and the
+// head is always the least value.
+//
+// Each pushed value is given an increasing index, and Evict drops the values
+// pushed before a given index from the head of the queue. This makes the queue
+// suitable for finding the least value of a sliding window, i.e. the last k
+// values of a stream, by evicting the values with an index older than k after
+// each push. As each value is pushed and dropped at most once, Push and Evict
+// are done in O(1) amortized time, while Front is done in O(1) time. The
+// values are kept in a Deque.
+func NewMonotonicQueue[T any](less func(a, b T) bool) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{
+		items: NewDeque[monotonicEntry[T]](),
+		less:  less,
+	}
+}
+
+// NewMinMonotonicQueue creates a new instance of a monotonic queue, where the
+// head is the smallest value.
+func NewMinMonotonicQueue[T cmp.Ordered]() *MonotonicQueue[T] {
+	return NewMonotonicQueue(func(a, b T) bool {
+		return a < b
+	})
+}
+
+// NewMaxMonotonicQueue creates a new instance of a monotonic queue, where the
+// head is the largest value.
+func NewMaxMonotonicQueue[T cmp.Ordered]() *MonotonicQueue[T] {
+	return NewMonotonicQueue(func(a, b T) bool {
+		return a > b
+	})
+}
+
+// MonotonicQueue defines a monotonic queue structure with a deque of values
+// and their indices, an ordering function, and the index of the next value.
+type MonotonicQueue[T any] struct {
+	items *Deque[monotonicEntry[T]]
+	less  func(a, b T) bool
+	next  int
+}
+
+// monotonicEntry defines a value of a monotonic queue and its index.
+type monotonicEntry[T any] struct {
+	val   T
+	index int
+}
+
+// IsEmpty checks if the queue is empty.
+func (q *MonotonicQueue[T]) IsEmpty() bool {
+	return q.items.IsEmpty()
+}
+
+// Size returns the number of values kept in the queue, which is at most the
+// number of values pushed since the last evicted index.
+func (q *MonotonicQueue[T]) Size() int {
+	return q.items.Size()
+}
+
+// Push adds a value to the tail of the queue, after dropping the values at the
+// tail that are not less than it, and returns the index of the value.
+func (q *MonotonicQueue[T]) Push(val T) int {
+	for !q.items.IsEmpty() {
+		e, _ := q.items.Back()
+		if q.less(e.val, val) {
+			break
+		}
+
+		q.items.PopBack()
+	}
+
+	i := q.next
+	q.items.PushBack(monotonicEntry[T]{val, i})
+	q.next++
+
+	return i
+}
+
+// Front returns the least value of the queue, unless the queue is empty.
+func (q *MonotonicQueue[T]) Front() (T, error) {
+	e, err := q.items.Front()
+	if err != nil {
+		return e.val, ErrQueueUnderflow
+	}
+
+	return e.val, nil
+}
+
+// Evict drops the values pushed before a given index from the head of the
+// queue.
+func (q *MonotonicQueue[T]) Evict(index int) {
+	for !q.items.IsEmpty() {
+		e, _ := q.items.Front()
+		if e.index >= index {
+			return
+		}
+
+		q.items.PopFront()
+	}
+}
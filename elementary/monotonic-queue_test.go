@@ -0,0 +1,90 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestMonotonicQueue(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewMinMonotonicQueue[int]()
+	assert.True(q.IsEmpty())
+	_, err := q.Front()
+	assert.Equal(elementary.ErrQueueUnderflow, err)
+
+	for i, v := range []int{5, 3, 4, 3, 6} {
+		assert.Equal(i, q.Push(v))
+	}
+
+	// only the last 3 and 6 are kept, as a value drops the values before it
+	// that are not less than it, including equal values
+	assert.Equal(2, q.Size())
+	v, err := q.Front()
+	assert.NoError(err)
+	assert.Equal(3, v)
+
+	q.Evict(3)
+	v, _ = q.Front()
+	assert.Equal(3, v)
+
+	q.Evict(4)
+	v, _ = q.Front()
+	assert.Equal(6, v)
+
+	q.Evict(5)
+	assert.True(q.IsEmpty())
+
+	// indices keep increasing after the queue is emptied
+	assert.Equal(5, q.Push(1))
+}
+
+func TestMonotonicQueueWindow(t *testing.T) {
+	assert := assert.New(t)
+	vals := []int{2, 7, 7, -1, 4, 4, 9, 0, 3, 3, -5, 8, 6, 6, 1}
+	tests := map[string]struct {
+		q    *elementary.MonotonicQueue[int]
+		best func(a, b int) int
+	}{
+		"should track the minimum": {
+			q:    elementary.NewMinMonotonicQueue[int](),
+			best: func(a, b int) int { return min(a, b) },
+		},
+		"should track the maximum": {
+			q:    elementary.NewMaxMonotonicQueue[int](),
+			best: func(a, b int) int { return max(a, b) },
+		},
+		"should track the least value by a less function": {
+			q: elementary.NewMonotonicQueue(func(a, b int) bool {
+				return a*a < b*b
+			}),
+			best: func(a, b int) int {
+				if b*b < a*a {
+					return b
+				}
+				return a
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			const k = 4
+			for i, v := range vals {
+				test.q.Evict(test.q.Push(v) - k + 1)
+
+				want := vals[max(0, i-k+1)]
+				for _, w := range vals[max(0, i-k+1) : i+1] {
+					want = test.best(want, w)
+				}
+
+				got, err := test.q.Front()
+				assert.NoError(err)
+				assert.Equal(want, got, "window ending at %d", i)
+				assert.LessOrEqual(test.q.Size(), k)
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package elementary
+
+// NewPairingHeap creates a new instance of a pairing heap, ordered by a less
+// function. A pairing heap is a single heap-ordered tree of any shape, where
+// each node keeps a pointer to its leftmost child and its right sibling. Two
+// heaps are melded by making the root with the greater value the leftmost
+// child of the other, so Insert and Meld are done in O(1) time. DecreaseKey
+// cuts the subtree of the node out of the tree, and melds it with the root.
+// Pop removes the root, and melds its children in two passes - first pairwise
+// from left to right, and then one by one from right to left - which is what
+// keeps the tree shallow. Pop is done in O(lg n) amortized time, and
+// DecreaseKey in o(lg n) amortized time. Despite the weaker bounds, the
+// pairing heap is simple and tends to outperform the Fibonacci heap in
+// practice.
+func NewPairingHeap[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{
+		less:  less,
+		owner: &heapOwner{},
+	}
+}
+
+// PairingHeap defines a pairing heap structure with a root, an ordering
+// function, a count of the elements in it and the owner of its elements.
+type PairingHeap[T any] struct {
+	root  *PairingElement[T]
+	less  func(a, b T) bool
+	count int
+	owner *heapOwner
+}
+
+// PairingElement defines an element of the pairing heap, which is a node of
+// its tree with a pointer to its leftmost child and its right sibling. The
+// previous pointer points to the left sibling, or to the parent for a leftmost
+// child, and the owner identifies the heap holding the element.
+type PairingElement[T any] struct {
+	child   *PairingElement[T]
+	sibling *PairingElement[T]
+	prev    *PairingElement[T]
+	removed bool
+	owner   *heapOwner
+	value   T
+}
+
+// Value returns the value of the element.
+func (e *PairingElement[T]) Value() T {
+	return e.value
+}
+
+// IsEmpty checks if the heap is empty.
+func (h *PairingHeap[T]) IsEmpty() bool {
+	return h.root == nil
+}
+
+// Size returns the total number of elements in the heap.
+func (h *PairingHeap[T]) Size() int {
+	return h.count
+}
+
+// Insert adds a value to the heap, by melding it with the root, and returns
+// the element holding it.
+func (h *PairingHeap[T]) Insert(val T) *PairingElement[T] {
+	e := &PairingElement[T]{
+		value: val,
+		owner: h.owner,
+	}
+
+	h.root = h.meld(h.root, e)
+	h.count++
+
+	return e
+}
+
+// Push adds a value to the heap.
+func (h *PairingHeap[T]) Push(val T) {
+	h.Insert(val)
+}
+
+// Peek returns the top value of the heap, unless the heap is empty. The value
+// is not removed.
+func (h *PairingHeap[T]) Peek() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	return h.root.value, nil
+}
+
+// Pop removes and returns the top value of the heap, unless the heap
+// underflows. The children of the root are melded in two passes to form the
+// new tree.
+func (h *PairingHeap[T]) Pop() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, ErrHeapUnderflow
+	}
+
+	r := h.root
+	h.root = h.combine(r.child)
+	h.count--
+
+	r.child = nil
+	r.removed = true
+
+	return r.value, nil
+}
+
+// Meld moves all elements of heap o into the heap, leaving o empty. Both heaps
+// must have the same ordering.
+func (h *PairingHeap[T]) Meld(o *PairingHeap[T]) {
+	if h == o {
+		return
+	}
+
+	h.root = h.meld(h.root, o.root)
+	h.owner = uniteOwners(h.owner, o.owner)
+	o.owner = &heapOwner{}
+	h.count += o.count
+	o.root = nil
+	o.count = 0
+}
+
+// DecreaseKey changes the value of a given element to one which is not ordered
+// after it. Unless the element is the root, its subtree is cut out of the
+// tree, and melded with the root. If the element is not in the heap, or the
+// new value is ordered after the current, an error is returned.
+func (h *PairingHeap[T]) DecreaseKey(e *PairingElement[T], val T) error {
+	if e == nil || e.removed || e.owner.find() != h.owner {
+		return ErrInvalidHandle
+	}
+
+	if h.less(e.value, val) {
+		return ErrKeyOrder
+	}
+
+	e.value = val
+	if e == h.root {
+		return nil
+	}
+
+	// cut the subtree of e out of the tree
+	if e.prev.child == e {
+		e.prev.child = e.sibling
+	} else {
+		e.prev.sibling = e.sibling
+	}
+
+	if e.sibling != nil {
+		e.sibling.prev = e.prev
+	}
+
+	e.prev = nil
+	e.sibling = nil
+	h.root = h.meld(h.root, e)
+
+	return nil
+}
+
+// meld melds two trees, by making the root with the greater value the
+// leftmost child of the other, and returns the root of the resulting tree.
+func (h *PairingHeap[T]) meld(a, b *PairingElement[T]) *PairingElement[T] {
+	if a == nil {
+		return b
+	}
+
+	if b == nil {
+		return a
+	}
+
+	if h.less(b.value, a.value) {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+
+	a.child = b
+
+	return a
+}
+
+// combine melds a list of siblings into a single tree in two passes. The
+// first pass melds the siblings in pairs from left to right, and the second
+// melds the resulting trees one by one from right to left.
+func (h *PairingHeap[T]) combine(first *PairingElement[T]) *PairingElement[T] {
+	var ts []*PairingElement[T]
+	for x := first; x != nil; {
+		next := x.sibling
+		x.prev = nil
+		x.sibling = nil
+		ts = append(ts, x)
+		x = next
+	}
+
+	if len(ts) == 0 {
+		return nil
+	}
+
+	// first pass: meld pairs from left to right
+	var ps []*PairingElement[T]
+	for i := 0; i < len(ts); i += 2 {
+		if i+1 < len(ts) {
+			ps = append(ps, h.meld(ts[i], ts[i+1]))
+		} else {
+			ps = append(ps, ts[i])
+		}
+	}
+
+	// second pass: meld from right to left
+	r := ps[len(ps)-1]
+	for i := len(ps) - 2; i >= 0; i-- {
+		r = h.meld(ps[i], r)
+	}
+
+	return r
+}
@@ -0,0 +1,175 @@
+package elementary
+
+import "sync"
+
+// NewPersistentQueue creates a new instance of an empty persistent queue. A
+// persistent queue is immutable, i.e. enqueueing or dequeueing an element
+// returns a new queue, while the original queue is left unchanged and remains
+// valid. The two queues share most of their structure, so no copying is
+// needed. The queue is Okasaki's banker's queue, which consists of two lists -
+// a front list holding the head of the queue, and a rear list holding the tail
+// of the queue in reverse order. Elements are enqueued by prepending them to
+// the rear list, and dequeued by removing them from the start of the front
+// list. Whenever the rear list grows longer than the front list, the reversed
+// rear list is appended to the front list.
+//
+// With two plain lists, as in DSQueue, the cost of the reversal is amortized
+// over the enqueues preceding it. This fails for a persistent queue, as the
+// same queue may be dequeued many times, each triggering the same expensive
+// reversal. The banker's queue makes the front list lazy instead, i.e. each
+// cell is only computed when it is first needed, after which the result is
+// memoized and shared by all queues referring to it. As the rear list is only
+// reversed once the front list is as long as it, the reversal is paid for by
+// the dequeues needed to reach it, which guarantees O(1) amortized time for
+// all operations, even when old versions of the queue are reused. A
+// persistent queue may be used from multiple goroutines concurrently.
+func NewPersistentQueue[T any]() *PersistentQueue[T] {
+	return &PersistentQueue[T]{}
+}
+
+// PersistentQueue defines a persistent queue structure with a lazy front list,
+// a rear list in reverse order, and the length of both.
+type PersistentQueue[T any] struct {
+	front    *lazyList[T]
+	rear     *consList[T]
+	frontLen int
+	rearLen  int
+}
+
+// consList defines a cell of an immutable singly linked list. The empty list
+// is nil.
+type consList[T any] struct {
+	head T
+	tail *consList[T]
+}
+
+// lazyList defines a suspended immutable singly linked list, which is computed
+// when forced for the first time, unless its cell is given up front. The empty
+// list is nil.
+type lazyList[T any] struct {
+	once sync.Once
+	fn   func() *lazyCell[T]
+	cell *lazyCell[T]
+}
+
+// lazyCell defines a computed cell of a lazy list.
+type lazyCell[T any] struct {
+	head T
+	tail *lazyList[T]
+}
+
+// force computes and returns the first cell of the list, which is nil for an
+// empty list. The cell is memoized, so it is only computed once.
+func (l *lazyList[T]) force() *lazyCell[T] {
+	if l == nil {
+		return nil
+	}
+
+	l.once.Do(func() {
+		if l.fn != nil {
+			l.cell = l.fn()
+			l.fn = nil
+		}
+	})
+
+	return l.cell
+}
+
+// IsEmpty checks if the queue is empty.
+func (q *PersistentQueue[T]) IsEmpty() bool {
+	return q.frontLen == 0
+}
+
+// Size returns the total number of elements in the queue.
+func (q *PersistentQueue[T]) Size() int {
+	return q.frontLen + q.rearLen
+}
+
+// Peek returns the head element value of the queue, unless the queue is empty.
+func (q *PersistentQueue[T]) Peek() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, ErrQueueUnderflow
+	}
+
+	return q.front.force().head, nil
+}
+
+// Enqueue returns a new queue with an element value added to the tail of the
+// queue.
+func (q *PersistentQueue[T]) Enqueue(val T) *PersistentQueue[T] {
+	return newBankersQueue(q.front, q.frontLen, &consList[T]{val, q.rear}, q.rearLen+1)
+}
+
+// Dequeue returns the head element value of the queue along with a new queue
+// without it, unless the queue underflows.
+func (q *PersistentQueue[T]) Dequeue() (T, *PersistentQueue[T], error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, q, ErrQueueUnderflow
+	}
+
+	c := q.front.force()
+	return c.head, newBankersQueue(c.tail, q.frontLen-1, q.rear, q.rearLen), nil
+}
+
+// Traverse loops through each element value in the queue from head to tail.
+func (q *PersistentQueue[T]) Traverse(f func(T)) {
+	for c := q.front.force(); c != nil; c = c.tail.force() {
+		f(c.head)
+	}
+
+	// the rear list holds the tail of the queue in reverse order
+	vals := make([]T, 0, q.rearLen)
+	for r := q.rear; r != nil; r = r.tail {
+		vals = append(vals, r.head)
+	}
+
+	for i := len(vals) - 1; i >= 0; i-- {
+		f(vals[i])
+	}
+}
+
+// newBankersQueue creates a queue from a front and a rear list, and restores
+// the invariant that the rear list is no longer than the front list, by lazily
+// appending the reversed rear list to the front list.
+func newBankersQueue[T any](front *lazyList[T], frontLen int, rear *consList[T], rearLen int) *PersistentQueue[T] {
+	if rearLen <= frontLen {
+		return &PersistentQueue[T]{front, rear, frontLen, rearLen}
+	}
+
+	return &PersistentQueue[T]{
+		front:    lazyAppend(front, lazyReverse(rear)),
+		frontLen: frontLen + rearLen,
+	}
+}
+
+// lazyAppend returns the lazy list of list a followed by list b. Each cell of
+// a is copied only when forced, so appending is incremental.
+func lazyAppend[T any](a, b *lazyList[T]) *lazyList[T] {
+	return &lazyList[T]{
+		fn: func() *lazyCell[T] {
+			c := a.force()
+			if c == nil {
+				return b.force()
+			}
+
+			return &lazyCell[T]{c.head, lazyAppend(c.tail, b)}
+		},
+	}
+}
+
+// lazyReverse returns the lazy list of a list in reverse order. The whole list
+// is reversed when the first cell is forced.
+func lazyReverse[T any](l *consList[T]) *lazyList[T] {
+	return &lazyList[T]{
+		fn: func() *lazyCell[T] {
+			var rev *lazyList[T]
+			for ; l != nil; l = l.tail {
+				rev = &lazyList[T]{cell: &lazyCell[T]{l.head, rev}}
+			}
+
+			return rev.force()
+		},
+	}
+}
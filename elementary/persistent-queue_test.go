@@ -0,0 +1,161 @@
+package elementary_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// persistentValues returns the values of a persistent queue from head to
+// tail.
+func persistentValues[T any](q *elementary.PersistentQueue[T]) []T {
+	var vals []T
+	q.Traverse(func(v T) {
+		vals = append(vals, v)
+	})
+
+	return vals
+}
+
+func TestPersistentQueue(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []int
+	}{
+		"should dequeue nothing from an empty queue": {},
+		"should dequeue a single value": {
+			vals: []int{1},
+		},
+		"should dequeue values in order of enqueueing": {
+			vals: []int{1, 2, 3, 2, 5, 8, 13, 21, 34},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q := elementary.NewPersistentQueue[int]()
+			for _, v := range test.vals {
+				q = q.Enqueue(v)
+			}
+
+			assert.Equal(len(test.vals), q.Size())
+			assert.Equal(test.vals, persistentValues(q))
+
+			var got []int
+			for !q.IsEmpty() {
+				v, err := q.Peek()
+				assert.NoError(err)
+
+				w, next, err := q.Dequeue()
+				assert.NoError(err)
+				assert.Equal(v, w)
+				got = append(got, w)
+				q = next
+			}
+			assert.Equal(test.vals, got)
+
+			_, err := q.Peek()
+			assert.Equal(elementary.ErrQueueUnderflow, err)
+			_, next, err := q.Dequeue()
+			assert.Equal(elementary.ErrQueueUnderflow, err)
+			assert.Equal(q, next)
+		})
+	}
+}
+
+func TestPersistentQueueVersions(t *testing.T) {
+	assert := assert.New(t)
+
+	// build a history of versions by interleaving enqueues and dequeues, and
+	// record the values each version holds when it is created
+	versions := []*elementary.PersistentQueue[int]{elementary.NewPersistentQueue[int]()}
+	want := [][]int{nil}
+	var model []int
+	for i := range 40 {
+		q := versions[len(versions)-1]
+		if i%3 == 2 {
+			_, q, _ = q.Dequeue()
+			model = model[1:]
+		} else {
+			q = q.Enqueue(i)
+			model = append(model, i)
+		}
+
+		versions = append(versions, q)
+		want = append(want, append([]int(nil), model...))
+	}
+
+	// branch off every version, both by enqueueing and dequeueing
+	for _, q := range versions {
+		q.Enqueue(-1).Enqueue(-2)
+		for p := q; !p.IsEmpty(); {
+			_, p, _ = p.Dequeue()
+		}
+	}
+
+	// no version is changed by the operations on the versions derived from it
+	for i, q := range versions {
+		if len(want[i]) == 0 {
+			assert.Nil(persistentValues(q), "version %d", i)
+		} else {
+			assert.Equal(want[i], persistentValues(q), "version %d", i)
+		}
+		assert.Equal(len(want[i]), q.Size(), "version %d", i)
+	}
+}
+
+func TestPersistentQueueReuse(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewPersistentQueue[string]()
+	for _, v := range []string{"a", "b", "c", "d"} {
+		q = q.Enqueue(v)
+	}
+
+	// dequeueing the same version twice gives the same value and contents
+	v1, q1, _ := q.Dequeue()
+	v2, q2, _ := q.Dequeue()
+	assert.Equal("a", v1)
+	assert.Equal(v1, v2)
+	assert.Equal(persistentValues(q1), persistentValues(q2))
+
+	// diverging versions do not affect each other
+	x := q1.Enqueue("x")
+	y := q1.Enqueue("y")
+	assert.Equal([]string{"b", "c", "d", "x"}, persistentValues(x))
+	assert.Equal([]string{"b", "c", "d", "y"}, persistentValues(y))
+	assert.Equal([]string{"b", "c", "d"}, persistentValues(q1))
+	assert.Equal([]string{"a", "b", "c", "d"}, persistentValues(q))
+}
+
+func TestPersistentQueueConcurrent(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewPersistentQueue[int]()
+	var want []int
+	for i := range 100 {
+		q = q.Enqueue(i)
+		want = append(want, i)
+	}
+
+	// the lazy front list is forced by many goroutines at once
+	var wg sync.WaitGroup
+	got := make([][]int, 8)
+	for i := range got {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := q; !p.IsEmpty(); {
+				var v int
+				v, p, _ = p.Dequeue()
+				got[i] = append(got[i], v)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, vals := range got {
+		assert.Equal(want, vals)
+	}
+}
@@ -0,0 +1,221 @@
+package elementary
+
+import (
+	"cmp"
+	"errors"
+)
+
+// Various errors a priority queue function can return.
+var (
+	ErrInvalidHandle = errors.New("element is not in the priority queue")
+)
+
+// NewPriorityQueue creates a new instance of an indexed priority queue, where
+// each element holds a value and a priority, and the ordering of priorities is
+// given by a less function. The elements are kept in a binary heap, with the
+// top element being the one of least priority. Unlike the index of an element
+// in a plain heap, which shifts whenever other elements are inserted or
+// removed, Insert returns the element itself as a stable handle. Each element
+// keeps track of its current index in the heap, so the priority of an element
+// can be updated, or the element removed, in O(lg n) time without searching
+// for it. This is exactly what is needed by Dijkstra's and Prim's algorithms,
+// where the priority of a vertex already in the queue is decreased whenever a
+// shorter edge to it is found. Insert, Pop, UpdatePriority and Remove are done
+// in O(lg n) time, while Peek and Contains are done in O(1) time.
+func NewPriorityQueue[V, P any](less func(a, b P) bool) *PriorityQueue[V, P] {
+	return &PriorityQueue[V, P]{
+		less: less,
+	}
+}
+
+// NewMinPriorityQueue creates a new instance of an indexed priority queue,
+// where the top element is the one with the smallest priority.
+func NewMinPriorityQueue[V any, P cmp.Ordered]() *PriorityQueue[V, P] {
+	return NewPriorityQueue[V](func(a, b P) bool {
+		return a < b
+	})
+}
+
+// NewMaxPriorityQueue creates a new instance of an indexed priority queue,
+// where the top element is the one with the largest priority.
+func NewMaxPriorityQueue[V any, P cmp.Ordered]() *PriorityQueue[V, P] {
+	return NewPriorityQueue[V](func(a, b P) bool {
+		return a > b
+	})
+}
+
+// PriorityQueue defines an indexed priority queue structure with a heap of
+// elements and an ordering function on their priorities.
+type PriorityQueue[V, P any] struct {
+	heap []*PriorityQueueElement[V, P]
+	less func(a, b P) bool
+}
+
+// PriorityQueueElement defines an element of the priority queue. The element
+// serves as a handle to the value in the queue, and keeps its index in the
+// heap up to date, which is -1 once the element has left the queue.
+type PriorityQueueElement[V, P any] struct {
+	pq       *PriorityQueue[V, P]
+	index    int
+	priority P
+	Value    V
+}
+
+// Priority returns the current priority of the element.
+func (e *PriorityQueueElement[V, P]) Priority() P {
+	return e.priority
+}
+
+// IsEmpty checks if the priority queue is empty.
+func (q *PriorityQueue[V, P]) IsEmpty() bool {
+	return len(q.heap) == 0
+}
+
+// Size returns the total number of elements in the priority queue.
+func (q *PriorityQueue[V, P]) Size() int {
+	return len(q.heap)
+}
+
+// Contains checks if a given element is in the priority queue.
+func (q *PriorityQueue[V, P]) Contains(e *PriorityQueueElement[V, P]) bool {
+	return e != nil && e.pq == q && e.index >= 0
+}
+
+// Insert adds a value with a given priority to the priority queue, and returns
+// the element holding it.
+func (q *PriorityQueue[V, P]) Insert(val V, priority P) *PriorityQueueElement[V, P] {
+	e := &PriorityQueueElement[V, P]{
+		pq:       q,
+		index:    len(q.heap),
+		priority: priority,
+		Value:    val,
+	}
+
+	q.heap = append(q.heap, e)
+	q.up(e.index)
+
+	return e
+}
+
+// Peek returns the top element of the priority queue, unless the queue is
+// empty. The element is not removed.
+func (q *PriorityQueue[V, P]) Peek() (*PriorityQueueElement[V, P], error) {
+	if q.IsEmpty() {
+		return nil, ErrHeapUnderflow
+	}
+
+	return q.heap[0], nil
+}
+
+// Pop removes and returns the top element of the priority queue, unless the
+// queue underflows.
+func (q *PriorityQueue[V, P]) Pop() (*PriorityQueueElement[V, P], error) {
+	if q.IsEmpty() {
+		return nil, ErrHeapUnderflow
+	}
+
+	e := q.heap[0]
+	q.remove(0)
+
+	return e, nil
+}
+
+// UpdatePriority changes the priority of a given element, and moves it either
+// up or down the heap until the heap property is satisfied. If the element is
+// not in the priority queue, an error is returned.
+func (q *PriorityQueue[V, P]) UpdatePriority(e *PriorityQueueElement[V, P], priority P) error {
+	if !q.Contains(e) {
+		return ErrInvalidHandle
+	}
+
+	e.priority = priority
+	q.fix(e.index)
+
+	return nil
+}
+
+// Remove removes a given element from the priority queue. If the element is
+// not in the priority queue, an error is returned.
+func (q *PriorityQueue[V, P]) Remove(e *PriorityQueueElement[V, P]) error {
+	if !q.Contains(e) {
+		return ErrInvalidHandle
+	}
+
+	q.remove(e.index)
+
+	return nil
+}
+
+// remove removes the element at index i of the heap. The bottom element takes
+// its place, and is moved either up or down until the heap property is
+// satisfied.
+func (q *PriorityQueue[V, P]) remove(i int) {
+	n := len(q.heap) - 1
+	e := q.heap[i]
+
+	q.swap(i, n)
+	q.heap[n] = nil
+	q.heap = q.heap[:n]
+	e.index = -1
+
+	if i < n {
+		q.fix(i)
+	}
+}
+
+// fix moves the element at index i either up or down the heap.
+func (q *PriorityQueue[V, P]) fix(i int) {
+	if !q.down(i) {
+		q.up(i)
+	}
+}
+
+// up moves the element at index i up the heap, by swapping it with its parent
+// as long as its priority is less than that of the parent.
+func (q *PriorityQueue[V, P]) up(i int) {
+	for i > 0 {
+		p := (i - 1) >> 1
+		if !q.less(q.heap[i].priority, q.heap[p].priority) {
+			break
+		}
+
+		q.swap(i, p)
+		i = p
+	}
+}
+
+// down moves the element at index i down the heap, by swapping it with the
+// child of least priority as long as that priority is less than that of the
+// element. It reports whether the element was moved.
+func (q *PriorityQueue[V, P]) down(i int) bool {
+	n := len(q.heap)
+	i0 := i
+	for {
+		least := i
+
+		l := (i << 1) + 1
+		if l < n && q.less(q.heap[l].priority, q.heap[least].priority) {
+			least = l
+		}
+
+		r := (i << 1) + 2
+		if r < n && q.less(q.heap[r].priority, q.heap[least].priority) {
+			least = r
+		}
+
+		if least == i {
+			return i > i0
+		}
+
+		q.swap(i, least)
+		i = least
+	}
+}
+
+// swap swaps the elements at index i and j of the heap, and updates their
+// indices.
+func (q *PriorityQueue[V, P]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
@@ -0,0 +1,126 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// popValues pops every element of a priority queue, and returns their values
+// in order.
+func popValues[V, P any](q *elementary.PriorityQueue[V, P]) []V {
+	var vals []V
+	for !q.IsEmpty() {
+		e, _ := q.Pop()
+		vals = append(vals, e.Value)
+	}
+
+	return vals
+}
+
+func TestPriorityQueue(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewMinPriorityQueue[string, int]()
+
+	_, err := q.Peek()
+	assert.Equal(elementary.ErrHeapUnderflow, err)
+	_, err = q.Pop()
+	assert.Equal(elementary.ErrHeapUnderflow, err)
+
+	q.Insert("c", 3)
+	q.Insert("a", 1)
+	q.Insert("b", 2)
+	assert.Equal(3, q.Size())
+
+	e, err := q.Peek()
+	assert.NoError(err)
+	assert.Equal("a", e.Value)
+	assert.Equal(1, e.Priority())
+
+	assert.Equal([]string{"a", "b", "c"}, popValues(q))
+}
+
+func TestPriorityQueueUpdatePriority(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		name     string
+		priority int
+		want     []string
+	}{
+		"should move an element up": {
+			name:     "d",
+			priority: 0,
+			want:     []string{"d", "a", "b", "c", "e"},
+		},
+		"should move an element down": {
+			name:     "a",
+			priority: 10,
+			want:     []string{"b", "c", "d", "e", "a"},
+		},
+		"should keep an element in place": {
+			name:     "c",
+			priority: 3,
+			want:     []string{"a", "b", "c", "d", "e"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q := elementary.NewMinPriorityQueue[string, int]()
+			handles := make(map[string]*elementary.PriorityQueueElement[string, int])
+			for i, n := range []string{"a", "b", "c", "d", "e"} {
+				handles[n] = q.Insert(n, i+1)
+			}
+
+			assert.NoError(q.UpdatePriority(handles[test.name], test.priority))
+			assert.Equal(test.priority, handles[test.name].Priority())
+			assert.Equal(test.want, popValues(q))
+		})
+	}
+}
+
+func TestPriorityQueueRemove(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewMaxPriorityQueue[string, int]()
+	a := q.Insert("a", 1)
+	b := q.Insert("b", 2)
+	c := q.Insert("c", 3)
+
+	assert.True(q.Contains(b))
+	assert.NoError(q.Remove(b))
+	assert.False(q.Contains(b))
+	assert.Equal(2, q.Size())
+
+	// a removed handle is rejected
+	assert.Equal(elementary.ErrInvalidHandle, q.Remove(b))
+	assert.Equal(elementary.ErrInvalidHandle, q.UpdatePriority(b, 10))
+
+	// a popped handle is rejected
+	e, _ := q.Pop()
+	assert.Equal(c, e)
+	assert.False(q.Contains(c))
+	assert.Equal(elementary.ErrInvalidHandle, q.Remove(c))
+
+	assert.True(q.Contains(a))
+	assert.False(q.Contains(nil))
+	assert.Equal([]string{"a"}, popValues(q))
+}
+
+func TestPriorityQueueForeignHandle(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewMinPriorityQueue[string, int]()
+	o := elementary.NewMinPriorityQueue[string, int]()
+	q.Insert("a", 1)
+	x := o.Insert("x", 2)
+
+	assert.False(q.Contains(x))
+	assert.Equal(elementary.ErrInvalidHandle, q.Remove(x))
+	assert.Equal(elementary.ErrInvalidHandle, q.UpdatePriority(x, 0))
+
+	// neither queue is affected
+	assert.Equal(1, q.Size())
+	assert.Equal(1, o.Size())
+	assert.Equal(2, x.Priority())
+}
@@ -1,51 +1,66 @@
 package elementary
 
-import "errors"
+import (
+	"errors"
+	"iter"
+)
 
 // Various errors a queue function can return.
 var (
 	ErrQueueUnderflow = errors.New("queue underflow")
 )
 
-// NewQueue creates a new instance of a Queue data structure. It's a basic queue
-// that implements a FIFO policy, with the basic operations peek, enqueue and
-// dequeue. Queue contains a head and a tail pointer to the first and last
+// NewQueue creates a new instance of a Queue data structure holding int
+// values. See NewQueueOf for a queue of any type.
+func NewQueue() *Queue {
+	return NewQueueOf[int]()
+}
+
+// NewQueueOf creates a new instance of a QueueOf data structure. It's a basic
+// queue that implements a FIFO policy, with the basic operations peek, enqueue
+// and dequeue. QueueOf contains a head and a tail pointer to the first and last
 // elements of the queue. When an element is enqueued. it takes its place at the
 // tail of the queue. When an element is dequeued, it is always from the head at
-// the queue. Queue has no upper bound on the number of elements, so it cannot
+// the queue. QueueOf has no upper bound on the number of elements, so it cannot
 // overflow. Attempts to dequeue from an empty queue will cause the queue to
 // underflow. All three operations are done in O(1) time.
-func NewQueue() *Queue {
-	return &Queue{}
+func NewQueueOf[T any]() *QueueOf[T] {
+	return &QueueOf[T]{}
 }
 
-// Queue defines a queue structure with a head and tail element and a count of
-// the elements in it.
-type Queue struct {
-	head  *QueueElement
-	tail  *QueueElement
+// Queue defines a queue of int values.
+type Queue = QueueOf[int]
+
+// QueueElement defines an element of a queue of int values.
+type QueueElement = QueueElementOf[int]
+
+// QueueOf defines a queue structure with a head and tail element and a count
+// of the elements in it.
+type QueueOf[T any] struct {
+	head  *QueueElementOf[T]
+	tail  *QueueElementOf[T]
 	count int
 }
 
-// QueueElement defines an element of the queue.
-type QueueElement struct {
-	next  *QueueElement
-	Value int
+// QueueElementOf defines an element of the queue.
+type QueueElementOf[T any] struct {
+	next  *QueueElementOf[T]
+	Value T
 }
 
 // IsEmpty checks if the queue is empty.
-func (q *Queue) IsEmpty() bool {
+func (q *QueueOf[T]) IsEmpty() bool {
 	return q.head == nil
 }
 
 // Peek returns the head element of the queue. The element is not dequeued.
-func (q *Queue) Peek() *QueueElement {
+func (q *QueueOf[T]) Peek() *QueueElementOf[T] {
 	return q.head
 }
 
 // Enqueue adds an element to the tail of the queue.
-func (q *Queue) Enqueue(val int) {
-	e := QueueElement{
+func (q *QueueOf[T]) Enqueue(val T) {
+	e := QueueElementOf[T]{
 		next:  nil,
 		Value: val,
 	}
@@ -65,7 +80,7 @@ func (q *Queue) Enqueue(val int) {
 
 // Dequeue removes and returns the head element of the queue, unless the queue
 // underflows.
-func (q *Queue) Dequeue() (*QueueElement, error) {
+func (q *QueueOf[T]) Dequeue() (*QueueElementOf[T], error) {
 	if q.IsEmpty() {
 		return nil, ErrQueueUnderflow
 	}
@@ -81,7 +96,7 @@ func (q *Queue) Dequeue() (*QueueElement, error) {
 }
 
 // Traverse loops through each node in the queue.
-func (q *Queue) Traverse(f func(*QueueElement)) {
+func (q *QueueOf[T]) Traverse(f func(*QueueElementOf[T])) {
 	e := q.head
 	if e != nil {
 		f(e)
@@ -93,7 +108,37 @@ func (q *Queue) Traverse(f func(*QueueElement)) {
 	}
 }
 
+// All returns an iterator over the element values in the queue from head to
+// tail.
+func (q *QueueOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := q.head; e != nil; e = e.next {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the element values in the queue from tail
+// to head. As the elements are only linked from the head, they are collected
+// first, which requires O(n) extra storage.
+func (q *QueueOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		es := make([]*QueueElementOf[T], 0, q.count)
+		for e := q.head; e != nil; e = e.next {
+			es = append(es, e)
+		}
+
+		for i := len(es) - 1; i >= 0; i-- {
+			if !yield(es[i].Value) {
+				return
+			}
+		}
+	}
+}
+
 // Size returns the total number of elements in the queue.
-func (q *Queue) Size() int {
+func (q *QueueOf[T]) Size() int {
 	return q.count
 }
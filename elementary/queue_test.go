@@ -0,0 +1,75 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestQueue(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []int
+	}{
+		"should dequeue nothing from an empty queue": {},
+		"should dequeue a single value": {
+			vals: []int{1},
+		},
+		"should dequeue values in order of enqueueing": {
+			vals: []int{1, 2, 3, 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			q := elementary.NewQueue()
+			for _, v := range test.vals {
+				q.Enqueue(v)
+			}
+
+			assert.Equal(len(test.vals), q.Size())
+			if len(test.vals) > 0 {
+				assert.Equal(test.vals[0], q.Peek().Value)
+			} else {
+				assert.Nil(q.Peek())
+			}
+
+			var got []int
+			q.Traverse(func(e *elementary.QueueElement) {
+				got = append(got, e.Value)
+			})
+			assert.Equal(test.vals, got)
+
+			got = nil
+			for !q.IsEmpty() {
+				e, err := q.Dequeue()
+				assert.NoError(err)
+				got = append(got, e.Value)
+			}
+			assert.Equal(test.vals, got)
+			assert.Equal(0, q.Size())
+
+			_, err := q.Dequeue()
+			assert.Equal(elementary.ErrQueueUnderflow, err)
+		})
+	}
+}
+
+func TestQueueOf(t *testing.T) {
+	assert := assert.New(t)
+	q := elementary.NewQueueOf[string]()
+
+	q.Enqueue("a")
+	q.Enqueue("b")
+	e, err := q.Dequeue()
+	assert.NoError(err)
+	assert.Equal("a", e.Value)
+
+	// the queue is reusable after being emptied
+	q.Dequeue()
+	q.Enqueue("c")
+	assert.Equal("c", q.Peek().Value)
+	assert.Equal(1, q.Size())
+}
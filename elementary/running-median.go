@@ -0,0 +1,61 @@
+package elementary
+
+// NewRunningMedian creates a new instance of a RunningMedian, which tracks the
+// median of a stream of values. The values are split between two heaps - a
+// max heap holding the lower half, and a min heap holding the upper half - such
+// that every value in the lower half is at most every value in the upper half,
+// and the lower half holds either the same number of values as the upper half
+// or one more. The median is then either the top of the lower half, or the
+// mean of the tops of both halves. Push is done in O(lg n) time, while Median
+// is done in O(1) time.
+func NewRunningMedian() *RunningMedian {
+	return &RunningMedian{
+		lo: NewMaxHeapOf[float64](),
+		hi: NewMinHeapOf[float64](),
+	}
+}
+
+// RunningMedian defines a running median structure with a heap for each half
+// of the values.
+type RunningMedian struct {
+	lo *Heap[float64]
+	hi *Heap[float64]
+}
+
+// Push adds a value to the stream. The value is added to the lower half if it
+// is at most the top of it, and to the upper half otherwise, after which the
+// halves are rebalanced by moving a top value from one half to the other.
+func (m *RunningMedian) Push(val float64) {
+	if m.lo.IsEmpty() || val <= m.lo.heap[0] {
+		m.lo.Push(val)
+	} else {
+		m.hi.Push(val)
+	}
+
+	if m.lo.Size() > m.hi.Size()+1 {
+		v, _ := m.lo.Pop()
+		m.hi.Push(v)
+	} else if m.hi.Size() > m.lo.Size() {
+		v, _ := m.hi.Pop()
+		m.lo.Push(v)
+	}
+}
+
+// Median returns the median of the values pushed so far, unless no values have
+// been pushed.
+func (m *RunningMedian) Median() (float64, error) {
+	if m.lo.IsEmpty() {
+		return 0, ErrHeapUnderflow
+	}
+
+	if m.lo.Size() > m.hi.Size() {
+		return m.lo.heap[0], nil
+	}
+
+	return (m.lo.heap[0] + m.hi.heap[0]) / 2, nil
+}
+
+// Size returns the total number of values pushed.
+func (m *RunningMedian) Size() int {
+	return m.lo.Size() + m.hi.Size()
+}
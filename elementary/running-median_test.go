@@ -0,0 +1,51 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestRunningMedian(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []float64
+		want []float64
+	}{
+		"should track the median of increasing values": {
+			vals: []float64{1, 2, 3, 4, 5},
+			want: []float64{1, 1.5, 2, 2.5, 3},
+		},
+		"should track the median of decreasing values": {
+			vals: []float64{5, 4, 3, 2, 1, 0},
+			want: []float64{5, 4.5, 4, 3.5, 3, 2.5},
+		},
+		"should track the median of values with duplicates and negatives": {
+			vals: []float64{2, -6, 2, 10, -1, 2, 7},
+			want: []float64{2, -2, 2, 2, 2, 2, 2},
+		},
+		"should track the median of alternating values": {
+			vals: []float64{0, 100, -100, 50, -50, 25},
+			want: []float64{0, 50, 0, 25, 0, 12.5},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := elementary.NewRunningMedian()
+			_, err := m.Median()
+			assert.Equal(elementary.ErrHeapUnderflow, err)
+
+			for i, v := range test.vals {
+				m.Push(v)
+				assert.Equal(i+1, m.Size())
+
+				got, err := m.Median()
+				assert.NoError(err)
+				assert.Equal(test.want[i], got, "after %d values", i+1)
+			}
+		})
+	}
+}
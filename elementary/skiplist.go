@@ -0,0 +1,351 @@
+package elementary
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"math/rand"
+)
+
+// Various errors a skip list function can return.
+var (
+	ErrKeyNotFound = errors.New("key not found")
+)
+
+const (
+	// skipListMaxLevel is the maximum number of levels of a skip list, which
+	// suffices for 4^32 elements.
+	skipListMaxLevel = 32
+
+	// skipListP is the inverse of the probability that an element on one
+	// level is also on the next level.
+	skipListP = 4
+)
+
+// NewSkipList creates a new instance of a skip list with ordered keys, where
+// the levels of the elements are drawn from a random source with a given seed.
+// See NewSkipListFunc.
+func NewSkipList[K cmp.Ordered, V any](seed int64) *SkipList[K, V] {
+	return NewSkipListFunc[K, V](func(a, b K) bool {
+		return a < b
+	}, seed)
+}
+
+// NewSkipListFunc creates a new instance of a skip list, where the keys are
+// ordered by a less function, and the levels of the elements are drawn from a
+// random source with a given seed. A skip list is an ordered map, which keeps
+// its elements in a sorted linked list, along with a hierarchy of increasingly
+// sparse linked lists on top of it, called levels. Each element is on the
+// bottom level, and an element on one level is also on the next level with
+// probability 1/4, so each level skips about 3 out of 4 elements of the level
+// below it. A search starts at the top level and moves right as far as it can
+// without passing the key, before dropping down a level, thereby finding a key
+// in O(lg n) expected time. Inserting and deleting an element only relinks
+// its neighbours on each of its levels, also in O(lg n) expected time.
+//
+// Each link further records its span, i.e. the number of elements on the
+// bottom level it skips, so the rank of a key, and the element at a given
+// rank, are found in O(lg n) expected time as well. As the balance of a skip
+// list relies on randomness rather than rotations, its operations are much
+// simpler than those of a balanced search tree, and as each change is local,
+// it lends itself to fine-grained locking or lock-free variants. Using the
+// same seed gives the same structure for the same sequence of operations.
+func NewSkipListFunc[K, V any](less func(a, b K) bool, seed int64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head: &skipNode[K, V]{
+			next: make([]*skipNode[K, V], skipListMaxLevel),
+			span: make([]int, skipListMaxLevel),
+		},
+		level: 1,
+		less:  less,
+		rnd:   rand.New(rand.NewSource(seed)),
+	}
+}
+
+// SkipList defines a skip list structure with a head element, the number of
+// levels in use, a count of the elements in it, an ordering function and a
+// random source.
+type SkipList[K, V any] struct {
+	head   *skipNode[K, V]
+	level  int
+	length int
+	less   func(a, b K) bool
+	rnd    *rand.Rand
+}
+
+// skipNode defines an element of a skip list with a key, a value, and the next
+// element and span of the link on each of its levels.
+type skipNode[K, V any] struct {
+	key  K
+	val  V
+	next []*skipNode[K, V]
+	span []int
+}
+
+// IsEmpty checks if the skip list is empty.
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.length == 0
+}
+
+// Len returns the total number of elements in the skip list.
+func (s *SkipList[K, V]) Len() int {
+	return s.length
+}
+
+// Search looks for an element with a given key and, if it exists, returns its
+// value.
+func (s *SkipList[K, V]) Search(key K) (V, bool) {
+	if x := s.ceiling(key); x != nil && !s.less(key, x.key) {
+		return x.val, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Insert adds an element with a given key and value to the skip list, or
+// updates the value if the key already exists. It reports whether a new
+// element was added.
+func (s *SkipList[K, V]) Insert(key K, val V) bool {
+	var (
+		update [skipListMaxLevel]*skipNode[K, V]
+		rank   [skipListMaxLevel]int
+	)
+
+	// find the last element before the key on each level, along with its rank
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+
+		for x.next[i] != nil && s.less(x.next[i].key, key) {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+
+		update[i] = x
+	}
+
+	if next := x.next[0]; next != nil && !s.less(key, next.key) {
+		next.val = val
+		return false
+	}
+
+	lvl := s.randomLevel()
+	if lvl > s.level {
+		// the head spans the whole list on the new levels
+		for i := s.level; i < lvl; i++ {
+			update[i] = s.head
+			s.head.span[i] = s.length
+		}
+
+		s.level = lvl
+	}
+
+	n := &skipNode[K, V]{
+		key:  key,
+		val:  val,
+		next: make([]*skipNode[K, V], lvl),
+		span: make([]int, lvl),
+	}
+
+	// link the element on each of its levels, and split the spans around it
+	for i := 0; i < lvl; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	// the links above the element now skip one more element
+	for i := lvl; i < s.level; i++ {
+		update[i].span[i]++
+	}
+
+	s.length++
+
+	return true
+}
+
+// Delete looks for an element with a given key and, if it exists, removes it
+// from the skip list.
+func (s *SkipList[K, V]) Delete(key K) error {
+	var update [skipListMaxLevel]*skipNode[K, V]
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.less(x.next[i].key, key) {
+			x = x.next[i]
+		}
+
+		update[i] = x
+	}
+
+	x = x.next[0]
+	if x == nil || s.less(key, x.key) {
+		return ErrKeyNotFound
+	}
+
+	// unlink the element on each of its levels, and merge the spans around it
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.length--
+
+	return nil
+}
+
+// Floor returns the element with the greatest key less than or equal to a
+// given key, unless no such element exists.
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	x := s.lower(key)
+	if next := x.next[0]; next != nil && !s.less(key, next.key) {
+		return next.key, next.val, true
+	}
+
+	if x == s.head {
+		var (
+			zk K
+			zv V
+		)
+		return zk, zv, false
+	}
+
+	return x.key, x.val, true
+}
+
+// Ceiling returns the element with the least key greater than or equal to a
+// given key, unless no such element exists.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	x := s.ceiling(key)
+	if x == nil {
+		var (
+			zk K
+			zv V
+		)
+		return zk, zv, false
+	}
+
+	return x.key, x.val, true
+}
+
+// Rank returns the number of elements with a key less than a given key, i.e.
+// the zero-based position of the key in the skip list, if it exists.
+func (s *SkipList[K, V]) Rank(key K) int {
+	r := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.less(x.next[i].key, key) {
+			r += x.span[i]
+			x = x.next[i]
+		}
+	}
+
+	return r
+}
+
+// At returns the element at a given zero-based rank, by moving right on each
+// level as long as the spans do not pass the rank. If the rank is out of
+// range, an error is returned.
+func (s *SkipList[K, V]) At(i int) (K, V, error) {
+	if i < 0 || i >= s.length {
+		var (
+			zk K
+			zv V
+		)
+		return zk, zv, ErrIndexRange
+	}
+
+	// the head has rank 0, so the element at rank i has position i+1
+	pos := 0
+	x := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for x.next[l] != nil && pos+x.span[l] <= i+1 {
+			pos += x.span[l]
+			x = x.next[l]
+		}
+	}
+
+	return x.key, x.val, nil
+}
+
+// Range loops through each element with a key between from and to, both
+// inclusive, in ascending order of keys.
+func (s *SkipList[K, V]) Range(from, to K, f func(K, V)) {
+	for x := s.ceiling(from); x != nil && !s.less(to, x.key); x = x.next[0] {
+		f(x.key, x.val)
+	}
+}
+
+// Traverse loops through each element in the skip list in ascending order of
+// keys.
+func (s *SkipList[K, V]) Traverse(f func(K, V)) {
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		f(x.key, x.val)
+	}
+}
+
+// All returns an iterator over the keys and values of the elements in the skip
+// list in ascending order of keys.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			if !yield(x.key, x.val) {
+				return
+			}
+		}
+	}
+}
+
+// Ascend returns an iterator over the keys and values of the elements with a
+// key greater than or equal to a given key, in ascending order of keys.
+func (s *SkipList[K, V]) Ascend(from K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.ceiling(from); x != nil; x = x.next[0] {
+			if !yield(x.key, x.val) {
+				return
+			}
+		}
+	}
+}
+
+// lower returns the last element with a key less than a given key, which is
+// the head if no such element exists.
+func (s *SkipList[K, V]) lower(key K) *skipNode[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.less(x.next[i].key, key) {
+			x = x.next[i]
+		}
+	}
+
+	return x
+}
+
+// ceiling returns the first element with a key greater than or equal to a
+// given key, or nil if no such element exists.
+func (s *SkipList[K, V]) ceiling(key K) *skipNode[K, V] {
+	return s.lower(key).next[0]
+}
+
+// randomLevel draws the number of levels of a new element, where each
+// additional level is added with probability 1/skipListP.
+func (s *SkipList[K, V]) randomLevel() int {
+	lvl := 1
+	for lvl < skipListMaxLevel && s.rnd.Intn(skipListP) == 0 {
+		lvl++
+	}
+
+	return lvl
+}
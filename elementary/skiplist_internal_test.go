@@ -0,0 +1,63 @@
+package elementary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// skipListLevels returns the number of levels of each element of a skip list
+// in ascending order of keys.
+func skipListLevels[K, V any](s *SkipList[K, V]) []int {
+	var lvls []int
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		lvls = append(lvls, len(x.next))
+	}
+
+	return lvls
+}
+
+// assertSpans asserts that the span of each link of a skip list is the number
+// of elements on the bottom level it skips.
+func assertSpans[K, V any](t *testing.T, s *SkipList[K, V]) {
+	t.Helper()
+
+	pos := map[*skipNode[K, V]]int{s.head: 0}
+	i := 1
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		pos[x] = i
+		i++
+	}
+
+	for x := s.head; x != nil; x = x.next[0] {
+		for l := 0; l < s.level && l < len(x.next); l++ {
+			if next := x.next[l]; next != nil {
+				assert.Equal(t, pos[next]-pos[x], x.span[l], "span of level %d at %d", l, pos[x])
+			}
+		}
+	}
+}
+
+func TestSkipListSeed(t *testing.T) {
+	assert := assert.New(t)
+	build := func(seed int64) *SkipList[int, int] {
+		s := NewSkipList[int, int](seed)
+		for i := range 1000 {
+			s.Insert((i*7919)%1000, i)
+		}
+		for i := 0; i < 1000; i += 5 {
+			s.Delete(i)
+		}
+
+		return s
+	}
+
+	a, b, c := build(42), build(42), build(43)
+	assertSpans(t, a)
+	assertSpans(t, c)
+
+	// the same seed gives the same levels, while another seed does not
+	assert.Equal(a.level, b.level)
+	assert.Equal(skipListLevels(a), skipListLevels(b))
+	assert.NotEqual(skipListLevels(a), skipListLevels(c))
+}
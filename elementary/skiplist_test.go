@@ -0,0 +1,263 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// newSkipList creates a skip list holding the keys 10, 20, ..., 10n, each with
+// its key divided by 10 as its value.
+func newSkipList(n int) *elementary.SkipList[int, int] {
+	s := elementary.NewSkipList[int, int](1)
+	for _, i := range []int{5, 2, 8, 1, 9, 3, 7, 4, 10, 6} {
+		if i <= n {
+			s.Insert(i*10, i)
+		}
+	}
+
+	return s
+}
+
+func TestSkipList(t *testing.T) {
+	assert := assert.New(t)
+	s := elementary.NewSkipList[string, int](1)
+	assert.True(s.IsEmpty())
+
+	_, ok := s.Search("a")
+	assert.False(ok)
+
+	assert.True(s.Insert("b", 2))
+	assert.True(s.Insert("a", 1))
+	assert.True(s.Insert("c", 3))
+	assert.False(s.Insert("b", 20))
+	assert.Equal(3, s.Len())
+
+	v, ok := s.Search("b")
+	assert.True(ok)
+	assert.Equal(20, v)
+
+	assert.NoError(s.Delete("b"))
+	assert.Equal(elementary.ErrKeyNotFound, s.Delete("b"))
+	assert.Equal(elementary.ErrKeyNotFound, s.Delete("z"))
+	_, ok = s.Search("b")
+	assert.False(ok)
+	assert.Equal(2, s.Len())
+
+	var keys []string
+	s.Traverse(func(k string, _ int) {
+		keys = append(keys, k)
+	})
+	assert.Equal([]string{"a", "c"}, keys)
+}
+
+func TestSkipListRank(t *testing.T) {
+	assert := assert.New(t)
+	s := newSkipList(10)
+	tests := map[string]struct {
+		key  int
+		want int
+	}{
+		"should rank a key before the first": {
+			key:  5,
+			want: 0,
+		},
+		"should rank the first key": {
+			key:  10,
+			want: 0,
+		},
+		"should rank a middle key": {
+			key:  50,
+			want: 4,
+		},
+		"should rank a missing key": {
+			key:  55,
+			want: 5,
+		},
+		"should rank the last key": {
+			key:  100,
+			want: 9,
+		},
+		"should rank a key after the last": {
+			key:  110,
+			want: 10,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.want, s.Rank(test.key))
+		})
+	}
+}
+
+func TestSkipListAt(t *testing.T) {
+	assert := assert.New(t)
+	s := newSkipList(10)
+	tests := map[string]struct {
+		index int
+		key   int
+		err   error
+	}{
+		"should return the first element": {
+			index: 0,
+			key:   10,
+		},
+		"should return a middle element": {
+			index: 6,
+			key:   70,
+		},
+		"should return the last element": {
+			index: 9,
+			key:   100,
+		},
+		"should return error for a negative index": {
+			index: -1,
+			err:   elementary.ErrIndexRange,
+		},
+		"should return error for an index past the end": {
+			index: 10,
+			err:   elementary.ErrIndexRange,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, v, err := s.At(test.index)
+			assert.Equal(test.err, err)
+			assert.Equal(test.key, k)
+			assert.Equal(test.key/10, v)
+		})
+	}
+}
+
+func TestSkipListRankAfterDelete(t *testing.T) {
+	assert := assert.New(t)
+	s := elementary.NewSkipList[int, int](7)
+	for i := range 500 {
+		s.Insert(i, i)
+	}
+
+	// delete every third key, so that the spans of many links are merged
+	for i := 0; i < 500; i += 3 {
+		assert.NoError(s.Delete(i))
+	}
+
+	var i int
+	for k := range s.All() {
+		assert.Equal(i, s.Rank(k))
+
+		got, _, err := s.At(i)
+		assert.NoError(err)
+		assert.Equal(k, got)
+		i++
+	}
+	assert.Equal(s.Len(), i)
+}
+
+func TestSkipListFloorCeiling(t *testing.T) {
+	assert := assert.New(t)
+	s := newSkipList(5)
+	tests := map[string]struct {
+		key   int
+		floor int
+		ceil  int
+		hasF  bool
+		hasC  bool
+	}{
+		"should find no floor before the first key": {
+			key:  5,
+			ceil: 10,
+			hasC: true,
+		},
+		"should find the key itself": {
+			key:   30,
+			floor: 30,
+			ceil:  30,
+			hasF:  true,
+			hasC:  true,
+		},
+		"should find the neighbours of a missing key": {
+			key:   35,
+			floor: 30,
+			ceil:  40,
+			hasF:  true,
+			hasC:  true,
+		},
+		"should find no ceiling after the last key": {
+			key:   55,
+			floor: 50,
+			hasF:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			k, v, ok := s.Floor(test.key)
+			assert.Equal(test.hasF, ok)
+			assert.Equal(test.floor, k)
+			assert.Equal(test.floor/10, v)
+
+			k, v, ok = s.Ceiling(test.key)
+			assert.Equal(test.hasC, ok)
+			assert.Equal(test.ceil, k)
+			assert.Equal(test.ceil/10, v)
+		})
+	}
+
+	empty := elementary.NewSkipList[int, int](1)
+	_, _, ok := empty.Floor(1)
+	assert.False(ok)
+	_, _, ok = empty.Ceiling(1)
+	assert.False(ok)
+}
+
+func TestSkipListRange(t *testing.T) {
+	assert := assert.New(t)
+	s := newSkipList(10)
+	tests := map[string]struct {
+		from, to int
+		want     []int
+	}{
+		"should range over all keys": {
+			from: 0,
+			to:   200,
+			want: []int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100},
+		},
+		"should include both bounds": {
+			from: 30,
+			to:   60,
+			want: []int{30, 40, 50, 60},
+		},
+		"should range between missing keys": {
+			from: 25,
+			to:   65,
+			want: []int{30, 40, 50, 60},
+		},
+		"should range over a single key": {
+			from: 70,
+			to:   70,
+			want: []int{70},
+		},
+		"should range over nothing between two keys": {
+			from: 71,
+			to:   79,
+		},
+		"should range over nothing for reversed bounds": {
+			from: 60,
+			to:   30,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []int
+			s.Range(test.from, test.to, func(k, _ int) {
+				got = append(got, k)
+			})
+			assert.Equal(test.want, got)
+		})
+	}
+}
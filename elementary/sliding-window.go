@@ -0,0 +1,291 @@
+package elementary
+
+import (
+	"cmp"
+	"errors"
+	"math"
+)
+
+// Various errors a sliding window function can return.
+var (
+	ErrInvalidWindow = errors.New("window size must be at least 1")
+)
+
+// NewSlidingMax creates a new instance of a SlidingExtremum, which tracks the
+// largest of the last k values of a stream. If k is less than 1, an error is
+// returned.
+func NewSlidingMax[T cmp.Ordered](k int) (*SlidingExtremum[T], error) {
+	return newSlidingExtremum(k, NewMaxMonotonicQueue[T]())
+}
+
+// NewSlidingMin creates a new instance of a SlidingExtremum, which tracks the
+// smallest of the last k values of a stream. If k is less than 1, an error is
+// returned.
+func NewSlidingMin[T cmp.Ordered](k int) (*SlidingExtremum[T], error) {
+	return newSlidingExtremum(k, NewMinMonotonicQueue[T]())
+}
+
+// NewSlidingExtremumFunc creates a new instance of a SlidingExtremum, which
+// tracks the least of the last k values of a stream according to a less
+// function. The values are kept in a monotonic queue, where the values older
+// than k are evicted after each push, so the head of the queue is the least
+// value of the window. Push is done in O(1) amortized time, compared to O(k)
+// time for rescanning the window, while Value is done in O(1) time. If k is
+// less than 1, an error is returned.
+func NewSlidingExtremumFunc[T any](k int, less func(a, b T) bool) (*SlidingExtremum[T], error) {
+	return newSlidingExtremum(k, NewMonotonicQueue(less))
+}
+
+// newSlidingExtremum creates a sliding window extremum with a window size of k
+// and a given monotonic queue.
+func newSlidingExtremum[T any](k int, q *MonotonicQueue[T]) (*SlidingExtremum[T], error) {
+	if k < 1 {
+		return nil, ErrInvalidWindow
+	}
+
+	return &SlidingExtremum[T]{
+		q: q,
+		k: k,
+	}, nil
+}
+
+// SlidingExtremum defines a sliding window extremum structure with a monotonic
+// queue, a window size, and the number of values pushed.
+type SlidingExtremum[T any] struct {
+	q     *MonotonicQueue[T]
+	k     int
+	count int
+}
+
+// Push adds a value to the stream, and evicts the value leaving the window.
+func (w *SlidingExtremum[T]) Push(val T) {
+	i := w.q.Push(val)
+	w.q.Evict(i - w.k + 1)
+	w.count++
+}
+
+// Value returns the extremum of the values in the window, unless no values
+// have been pushed.
+func (w *SlidingExtremum[T]) Value() (T, error) {
+	return w.q.Front()
+}
+
+// Size returns the number of values in the window, which is at most k.
+func (w *SlidingExtremum[T]) Size() int {
+	return min(w.count, w.k)
+}
+
+// NewSlidingSum creates a new instance of a SlidingSum, which tracks the sum
+// and mean of the last k values of a stream. The values of the window are kept
+// in a Deque, and the sum is updated by adding each pushed value and
+// subtracting the value leaving the window, so Push, Sum and Mean are done in
+// O(1) time. As repeatedly adding and subtracting floating point values lets
+// rounding errors accumulate over a long stream, the sum is compensated with
+// Neumaier's variant of Kahan summation, which keeps the rounding error of each
+// step in a separate term. Unlike plain Kahan summation, it stays accurate when
+// a value is larger than the sum, as when a large value leaves the window.
+//
+// As a NaN or infinite value would leave the sum NaN for good, even after the
+// value leaves the window, such values are counted rather than summed. While
+// the window holds a NaN, or infinite values of both signs, the sum is NaN, and
+// while it holds infinite values of one sign only, the sum is that infinity.
+// Once they have left the window, the sum is that of the finite values again.
+// If k is less than 1, an error is returned.
+func NewSlidingSum(k int) (*SlidingSum, error) {
+	if k < 1 {
+		return nil, ErrInvalidWindow
+	}
+
+	return &SlidingSum{
+		vals: NewDeque[float64](),
+		k:    k,
+	}, nil
+}
+
+// SlidingSum defines a sliding window sum structure with a deque of the values
+// in the window, a window size, a compensated sum of the finite values, and a
+// count of the NaN and infinite values.
+type SlidingSum struct {
+	vals   *Deque[float64]
+	k      int
+	sum    float64
+	comp   float64
+	nan    int
+	posInf int
+	negInf int
+}
+
+// Push adds a value to the stream, and removes the value leaving the window
+// from the sum.
+func (w *SlidingSum) Push(val float64) {
+	w.vals.PushBack(val)
+	w.add(val, 1)
+
+	if w.vals.Size() > w.k {
+		old, _ := w.vals.PopFront()
+		w.add(old, -1)
+	}
+}
+
+// Sum returns the sum of the values in the window, which is 0 if no values
+// have been pushed.
+func (w *SlidingSum) Sum() float64 {
+	switch {
+	case w.nan > 0 || (w.posInf > 0 && w.negInf > 0):
+		return math.NaN()
+	case w.posInf > 0:
+		return math.Inf(1)
+	case w.negInf > 0:
+		return math.Inf(-1)
+	}
+
+	return w.sum + w.comp
+}
+
+// Mean returns the mean of the values in the window, unless no values have
+// been pushed.
+func (w *SlidingSum) Mean() (float64, error) {
+	if w.vals.IsEmpty() {
+		return 0, ErrQueueUnderflow
+	}
+
+	return w.Sum() / float64(w.vals.Size()), nil
+}
+
+// Size returns the number of values in the window, which is at most k.
+func (w *SlidingSum) Size() int {
+	return w.vals.Size()
+}
+
+// add adds a value to the sum if sign is 1, or subtracts it if sign is -1. A
+// finite value is added using compensated summation, while a NaN or infinite
+// value only updates its count.
+func (w *SlidingSum) add(val float64, sign int) {
+	switch {
+	case math.IsNaN(val):
+		w.nan += sign
+		return
+	case math.IsInf(val, 1):
+		w.posInf += sign
+		return
+	case math.IsInf(val, -1):
+		w.negInf += sign
+		return
+	}
+
+	v := float64(sign) * val
+	t := w.sum + v
+	if math.Abs(w.sum) >= math.Abs(v) {
+		w.comp += (w.sum - t) + v
+	} else {
+		w.comp += (v - t) + w.sum
+	}
+
+	w.sum = t
+}
+
+// NewSlidingMedian creates a new instance of a SlidingMedian, which tracks the
+// median of the last k values of a stream. Like RunningMedian, the values are
+// split between a max heap holding the lower half and a min heap holding the
+// upper half, but as values must also leave the window, the heaps are indexed
+// priority queues, and each value is kept in a slot holding its current
+// handle, with the slots kept in a Deque in the order they were pushed. When a
+// value leaves the window, its slot is dequeued and its handle removed from
+// the heap it is in, after which the halves are rebalanced. Push is done in
+// O(lg k) time, compared to O(k lg k) time for sorting the window, while
+// Median is done in O(1) time. If k is less than 1, an error is returned.
+func NewSlidingMedian(k int) (*SlidingMedian, error) {
+	if k < 1 {
+		return nil, ErrInvalidWindow
+	}
+
+	return &SlidingMedian{
+		lo:    NewMaxPriorityQueue[*medianSlot, float64](),
+		hi:    NewMinPriorityQueue[*medianSlot, float64](),
+		slots: NewDeque[*medianSlot](),
+		k:     k,
+	}, nil
+}
+
+// SlidingMedian defines a sliding window median structure with a priority
+// queue for each half of the values in the window, a deque of their slots,
+// and a window size.
+type SlidingMedian struct {
+	lo    *PriorityQueue[*medianSlot, float64]
+	hi    *PriorityQueue[*medianSlot, float64]
+	slots *Deque[*medianSlot]
+	k     int
+}
+
+// medianSlot defines the handle of a value in the window. The handle changes
+// whenever the value moves from one half to the other, while the slot stays
+// in place in the deque.
+type medianSlot struct {
+	e *PriorityQueueElement[*medianSlot, float64]
+}
+
+// Push adds a value to the stream, and removes the value leaving the window.
+func (w *SlidingMedian) Push(val float64) {
+	if w.slots.Size() == w.k {
+		s, _ := w.slots.PopFront()
+		if w.lo.Contains(s.e) {
+			w.lo.Remove(s.e)
+		} else {
+			w.hi.Remove(s.e)
+		}
+
+		// rebalance before routing the new value by the top of the lower half
+		w.rebalance()
+	}
+
+	s := &medianSlot{}
+	if top, err := w.lo.Peek(); err != nil || val <= top.Priority() {
+		s.e = w.lo.Insert(s, val)
+	} else {
+		s.e = w.hi.Insert(s, val)
+	}
+
+	w.slots.PushBack(s)
+	w.rebalance()
+}
+
+// Median returns the median of the values in the window, unless no values have
+// been pushed.
+func (w *SlidingMedian) Median() (float64, error) {
+	lo, err := w.lo.Peek()
+	if err != nil {
+		return 0, ErrQueueUnderflow
+	}
+
+	if w.lo.Size() > w.hi.Size() {
+		return lo.Priority(), nil
+	}
+
+	hi, _ := w.hi.Peek()
+	return (lo.Priority() + hi.Priority()) / 2, nil
+}
+
+// Size returns the number of values in the window, which is at most k.
+func (w *SlidingMedian) Size() int {
+	return w.slots.Size()
+}
+
+// rebalance restores the invariant that the lower half holds either the same
+// number of values as the upper half or one more, by moving top values from
+// one half to the other.
+func (w *SlidingMedian) rebalance() {
+	for w.lo.Size() > w.hi.Size()+1 {
+		w.move(w.lo, w.hi)
+	}
+
+	for w.hi.Size() > w.lo.Size() {
+		w.move(w.hi, w.lo)
+	}
+}
+
+// move moves the top value of one half to the other half, and updates the
+// handle in its slot.
+func (w *SlidingMedian) move(from, to *PriorityQueue[*medianSlot, float64]) {
+	e, _ := from.Pop()
+	e.Value.e = to.Insert(e.Value, e.Priority())
+}
@@ -0,0 +1,183 @@
+package elementary_test
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// windowStream returns a seeded stream of small integer valued floats, with
+// many duplicates.
+func windowStream(n int) []float64 {
+	r := rand.New(rand.NewSource(1))
+	vals := make([]float64, n)
+	for i := range vals {
+		vals[i] = float64(r.Intn(21) - 10)
+	}
+
+	return vals
+}
+
+// window returns the last k values of a stream ending at index i.
+func window(vals []float64, i, k int) []float64 {
+	return vals[max(0, i-k+1) : i+1]
+}
+
+func TestNewSlidingWindow(t *testing.T) {
+	assert := assert.New(t)
+	for _, k := range []int{-1, 0} {
+		_, err := elementary.NewSlidingMax[int](k)
+		assert.Equal(elementary.ErrInvalidWindow, err)
+		_, err = elementary.NewSlidingMin[int](k)
+		assert.Equal(elementary.ErrInvalidWindow, err)
+		_, err = elementary.NewSlidingExtremumFunc(k, func(a, b int) bool { return a < b })
+		assert.Equal(elementary.ErrInvalidWindow, err)
+		_, err = elementary.NewSlidingSum(k)
+		assert.Equal(elementary.ErrInvalidWindow, err)
+		_, err = elementary.NewSlidingMedian(k)
+		assert.Equal(elementary.ErrInvalidWindow, err)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	assert := assert.New(t)
+	vals := windowStream(200)
+	tests := map[string]struct {
+		k int
+	}{
+		"should track a window of 1": {
+			k: 1,
+		},
+		"should track a window of 2": {
+			k: 2,
+		},
+		"should track a window of even size": {
+			k: 6,
+		},
+		"should track a window of odd size": {
+			k: 7,
+		},
+		"should track a window larger than the stream": {
+			k: 500,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			wmax, _ := elementary.NewSlidingMax[float64](test.k)
+			wmin, _ := elementary.NewSlidingMin[float64](test.k)
+			wsum, _ := elementary.NewSlidingSum(test.k)
+			wmed, _ := elementary.NewSlidingMedian(test.k)
+
+			_, err := wmax.Value()
+			assert.Equal(elementary.ErrQueueUnderflow, err)
+			_, err = wmed.Median()
+			assert.Equal(elementary.ErrQueueUnderflow, err)
+			_, err = wsum.Mean()
+			assert.Equal(elementary.ErrQueueUnderflow, err)
+			assert.Equal(0.0, wsum.Sum())
+
+			for i, v := range vals {
+				wmax.Push(v)
+				wmin.Push(v)
+				wsum.Push(v)
+				wmed.Push(v)
+
+				w := window(vals, i, test.k)
+				assert.Equal(len(w), wmax.Size())
+				assert.Equal(len(w), wsum.Size())
+				assert.Equal(len(w), wmed.Size())
+
+				got, _ := wmax.Value()
+				assert.Equal(slices.Max(w), got, "max of window ending at %d", i)
+				got, _ = wmin.Value()
+				assert.Equal(slices.Min(w), got, "min of window ending at %d", i)
+
+				var sum float64
+				for _, x := range w {
+					sum += x
+				}
+				assert.Equal(sum, wsum.Sum(), "sum of window ending at %d", i)
+				mean, _ := wsum.Mean()
+				assert.InDelta(sum/float64(len(w)), mean, 1e-12)
+
+				sorted := slices.Sorted(slices.Values(w))
+				want := sorted[len(sorted)/2]
+				if len(sorted)%2 == 0 {
+					want = (sorted[len(sorted)/2-1] + want) / 2
+				}
+				got, _ = wmed.Median()
+				assert.Equal(want, got, "median of window ending at %d", i)
+			}
+		})
+	}
+}
+
+func TestSlidingSumCompensated(t *testing.T) {
+	assert := assert.New(t)
+	w, _ := elementary.NewSlidingSum(3)
+
+	// large values passing through the window leave no rounding error behind
+	for range 1000 {
+		w.Push(1e16)
+		w.Push(1)
+		w.Push(-1e16)
+	}
+	for _, v := range []float64{0.1, 0.2, 0.3} {
+		w.Push(v)
+	}
+
+	assert.InDelta(0.6, w.Sum(), 1e-15)
+}
+
+func TestSlidingSumNonFinite(t *testing.T) {
+	assert := assert.New(t)
+	nan, inf := math.NaN(), math.Inf(1)
+	tests := map[string]struct {
+		vals []float64
+		want []float64
+	}{
+		"should recover after a NaN leaves the window": {
+			vals: []float64{1, nan, 2, 3, 4},
+			want: []float64{1, nan, nan, 5, 7},
+		},
+		"should recover after an infinity leaves the window": {
+			vals: []float64{1, inf, 2, 3, 4},
+			want: []float64{1, inf, inf, 5, 7},
+		},
+		"should recover after a negative infinity leaves the window": {
+			vals: []float64{1, -inf, 2, 3, 4},
+			want: []float64{1, -inf, -inf, 5, 7},
+		},
+		"should be NaN with infinities of both signs": {
+			vals: []float64{inf, -inf, 1, -inf, 2, 3},
+			want: []float64{inf, nan, -inf, -inf, -inf, 5},
+		},
+		"should be NaN with a NaN and an infinity": {
+			vals: []float64{nan, inf, 1, 2},
+			want: []float64{nan, nan, inf, 3},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			w, _ := elementary.NewSlidingSum(2)
+			for i, v := range test.vals {
+				w.Push(v)
+				mean, _ := w.Mean()
+				if math.IsNaN(test.want[i]) {
+					assert.True(math.IsNaN(w.Sum()), "sum after %d values", i+1)
+					assert.True(math.IsNaN(mean), "mean after %d values", i+1)
+				} else {
+					assert.Equal(test.want[i], w.Sum(), "sum after %d values", i+1)
+					assert.Equal(test.want[i]/float64(w.Size()), mean)
+				}
+			}
+		})
+	}
+}
@@ -1,50 +1,65 @@
 package elementary
 
-import "errors"
+import (
+	"errors"
+	"iter"
+)
 
 // Various errors a stack function can return.
 var (
 	ErrStackUnderflow = errors.New("stack underflow")
 )
 
-// NewStack creates a new instance of a Stack data structure. It's a basic stack
-// that implements a LIFO policy, with the basic operations peek, pop and push.
-// Stack contains a top pointer to the top element of the stack. When an element
-// is pushed, it takes its place at the top of the stack. When an element is
-// popped, it is always from the top of the stack. Stack has no upper bound on
-// the number of elements, so it cannot overflow. Attempts to pop from an empty
-// stack will cause the tack to underflow. All three operations are done in O(1)
-// time.
+// NewStack creates a new instance of a Stack data structure holding int
+// values. See NewStackOf for a stack of any type.
 func NewStack() *Stack {
-	return &Stack{}
+	return NewStackOf[int]()
+}
+
+// NewStackOf creates a new instance of a StackOf data structure. It's a basic
+// stack that implements a LIFO policy, with the basic operations peek, pop and
+// push. StackOf contains a top pointer to the top element of the stack. When an
+// element is pushed, it takes its place at the top of the stack. When an
+// element is popped, it is always from the top of the stack. StackOf has no
+// upper bound on the number of elements, so it cannot overflow. Attempts to pop
+// from an empty stack will cause the tack to underflow. All three operations
+// are done in O(1) time.
+func NewStackOf[T any]() *StackOf[T] {
+	return &StackOf[T]{}
 }
 
-// StackElement defines an element of the stack.
-type StackElement struct {
-	next  *StackElement
-	Value int
+// Stack defines a stack of int values.
+type Stack = StackOf[int]
+
+// StackElement defines an element of a stack of int values.
+type StackElement = StackElementOf[int]
+
+// StackElementOf defines an element of the stack.
+type StackElementOf[T any] struct {
+	next  *StackElementOf[T]
+	Value T
 }
 
-// Stack defines a stack structure with a top element and a count of the
+// StackOf defines a stack structure with a top element and a count of the
 // elements in it.
-type Stack struct {
-	top   *StackElement
+type StackOf[T any] struct {
+	top   *StackElementOf[T]
 	count int
 }
 
 // IsEmpty checks if the stack is empty.
-func (s *Stack) IsEmpty() bool {
+func (s *StackOf[T]) IsEmpty() bool {
 	return s.top == nil
 }
 
 // Peek returns the top element of the stack. The element is not pushed.
-func (s *Stack) Peek() *StackElement {
+func (s *StackOf[T]) Peek() *StackElementOf[T] {
 	return s.top
 }
 
 // Push adds an element to the top of the stack.
-func (s *Stack) Push(val int) {
-	e := &StackElement{
+func (s *StackOf[T]) Push(val T) {
+	e := &StackElementOf[T]{
 		next:  s.top,
 		Value: val,
 	}
@@ -55,7 +70,7 @@ func (s *Stack) Push(val int) {
 
 // Pop removes and returns the top element of the stack, unless the stack
 // underflows.
-func (s *Stack) Pop() (*StackElement, error) {
+func (s *StackOf[T]) Pop() (*StackElementOf[T], error) {
 	if s.IsEmpty() {
 		return nil, ErrStackUnderflow
 	}
@@ -67,7 +82,7 @@ func (s *Stack) Pop() (*StackElement, error) {
 }
 
 // Traverse loops through each element in the stack.
-func (s *Stack) Traverse(f func(*StackElement)) {
+func (s *StackOf[T]) Traverse(f func(*StackElementOf[T])) {
 	e := s.top
 	if e != nil {
 		f(e)
@@ -79,7 +94,37 @@ func (s *Stack) Traverse(f func(*StackElement)) {
 	}
 }
 
+// All returns an iterator over the element values in the stack from top to
+// bottom.
+func (s *StackOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := s.top; e != nil; e = e.next {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the element values in the stack from
+// bottom to top. As the elements are only linked from the top, they are
+// collected first, which requires O(n) extra storage.
+func (s *StackOf[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		es := make([]*StackElementOf[T], 0, s.count)
+		for e := s.top; e != nil; e = e.next {
+			es = append(es, e)
+		}
+
+		for i := len(es) - 1; i >= 0; i-- {
+			if !yield(es[i].Value) {
+				return
+			}
+		}
+	}
+}
+
 // Size returns the total number elements in the stack.
-func (s *Stack) Size() int {
+func (s *StackOf[T]) Size() int {
 	return s.count
 }
@@ -0,0 +1,76 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestStack(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		vals []int
+		want []int
+	}{
+		"should pop nothing from an empty stack": {},
+		"should pop a single value": {
+			vals: []int{1},
+			want: []int{1},
+		},
+		"should pop values in reverse order of pushing": {
+			vals: []int{1, 2, 3, 2},
+			want: []int{2, 3, 2, 1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := elementary.NewStack()
+			for _, v := range test.vals {
+				s.Push(v)
+			}
+
+			assert.Equal(len(test.vals), s.Size())
+			if len(test.want) > 0 {
+				assert.Equal(test.want[0], s.Peek().Value)
+			} else {
+				assert.Nil(s.Peek())
+			}
+
+			var got []int
+			s.Traverse(func(e *elementary.StackElement) {
+				got = append(got, e.Value)
+			})
+			assert.Equal(test.want, got)
+
+			got = nil
+			for !s.IsEmpty() {
+				e, err := s.Pop()
+				assert.NoError(err)
+				got = append(got, e.Value)
+			}
+			assert.Equal(test.want, got)
+			assert.Equal(0, s.Size())
+
+			_, err := s.Pop()
+			assert.Equal(elementary.ErrStackUnderflow, err)
+		})
+	}
+}
+
+func TestStackOf(t *testing.T) {
+	assert := assert.New(t)
+	s := elementary.NewStackOf[string]()
+
+	s.Push("a")
+	s.Push("b")
+	assert.Equal("b", s.Peek().Value)
+
+	e, err := s.Pop()
+	assert.NoError(err)
+	assert.Equal("b", e.Value)
+	assert.Equal(1, s.Size())
+	assert.False(s.IsEmpty())
+}
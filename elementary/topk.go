@@ -0,0 +1,88 @@
+package elementary
+
+// NewTopK creates a new instance of a Selector, which keeps track of the k
+// greatest values pushed to it according to a less function.
+func NewTopK[T any](k int, less func(a, b T) bool) *Selector[T] {
+	return &Selector[T]{
+		k:    k,
+		heap: NewHeap(less),
+	}
+}
+
+// NewBottomK creates a new instance of a Selector, which keeps track of the k
+// least values pushed to it according to a less function.
+func NewBottomK[T any](k int, less func(a, b T) bool) *Selector[T] {
+	return NewTopK(k, func(a, b T) bool {
+		return less(b, a)
+	})
+}
+
+// Selector defines a streaming selection of the k best values of a sequence,
+// without holding on to the whole sequence. The selected values are kept in a
+// heap bounded to k elements, with the worst of them at the top. Once the heap
+// is full, each new value is compared to the top, and replaces it if it is
+// better. Each value is thus processed in O(lg k) time, and a sequence of n
+// values in O(n lg k) time using O(k) storage.
+type Selector[T any] struct {
+	k    int
+	heap *Heap[T]
+}
+
+// Push offers a value to the selection, which keeps it if it is among the k
+// best values seen so far.
+func (s *Selector[T]) Push(val T) {
+	if s.k <= 0 {
+		return
+	}
+
+	if s.heap.Size() < s.k {
+		s.heap.Push(val)
+		return
+	}
+
+	if s.heap.less(s.heap.heap[0], val) {
+		s.heap.heap[0] = val
+		s.heap.down(0)
+	}
+}
+
+// Size returns the number of values currently selected, which is at most k.
+func (s *Selector[T]) Size() int {
+	return s.heap.Size()
+}
+
+// Values returns the selected values ordered from best to worst, i.e. in
+// descending order for a top k selection, and in ascending order for a bottom
+// k selection.
+func (s *Selector[T]) Values() []T {
+	vs := make([]T, s.heap.Size())
+	copy(vs, s.heap.heap)
+
+	HeapSortFunc(vs, func(a, b T) bool {
+		return s.heap.less(b, a)
+	})
+
+	return vs
+}
+
+// TopK returns the k greatest values of a slice according to a less function,
+// in descending order.
+func TopK[T any](vals []T, k int, less func(a, b T) bool) []T {
+	s := NewTopK(k, less)
+	for _, v := range vals {
+		s.Push(v)
+	}
+
+	return s.Values()
+}
+
+// BottomK returns the k least values of a slice according to a less function,
+// in ascending order.
+func BottomK[T any](vals []T, k int, less func(a, b T) bool) []T {
+	s := NewBottomK(k, less)
+	for _, v := range vals {
+		s.Push(v)
+	}
+
+	return s.Values()
+}
@@ -0,0 +1,78 @@
+package elementary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+func TestTopK(t *testing.T) {
+	assert := assert.New(t)
+	less := func(a, b int) bool { return a < b }
+	vals := []int{5, -1, 8, 3, 8, 0, -4, 2}
+	tests := map[string]struct {
+		k      int
+		top    []int
+		bottom []int
+	}{
+		"should select nothing for a k of 0": {
+			k:      0,
+			top:    []int{},
+			bottom: []int{},
+		},
+		"should select nothing for a negative k": {
+			k:      -2,
+			top:    []int{},
+			bottom: []int{},
+		},
+		"should select a single value": {
+			k:      1,
+			top:    []int{8},
+			bottom: []int{-4},
+		},
+		"should select k values with duplicates": {
+			k:      3,
+			top:    []int{8, 8, 5},
+			bottom: []int{-4, -1, 0},
+		},
+		"should select all values for a k of n": {
+			k:      8,
+			top:    []int{8, 8, 5, 3, 2, 0, -1, -4},
+			bottom: []int{-4, -1, 0, 2, 3, 5, 8, 8},
+		},
+		"should select all values for a k greater than n": {
+			k:      20,
+			top:    []int{8, 8, 5, 3, 2, 0, -1, -4},
+			bottom: []int{-4, -1, 0, 2, 3, 5, 8, 8},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.top, elementary.TopK(vals, test.k, less))
+			assert.Equal(test.bottom, elementary.BottomK(vals, test.k, less))
+		})
+	}
+}
+
+func TestSelector(t *testing.T) {
+	assert := assert.New(t)
+	s := elementary.NewTopK(2, func(a, b int) bool { return a < b })
+	assert.Equal([]int{}, s.Values())
+
+	s.Push(1)
+	assert.Equal(1, s.Size())
+	assert.Equal([]int{1}, s.Values())
+
+	for _, v := range []int{4, 2, 6, 3} {
+		s.Push(v)
+	}
+	assert.Equal(2, s.Size())
+	assert.Equal([]int{6, 4}, s.Values())
+
+	// Values leaves the selection intact
+	s.Push(5)
+	assert.Equal([]int{6, 5}, s.Values())
+}
@@ -0,0 +1,114 @@
+package geometry
+
+import (
+	"math"
+	"slices"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// ClosestPair returns the two closest points of a set of 2-dimensional points,
+// along with the distance between them, using a divide and conquer strategy.
+// The points are sorted by x, and split in half by a vertical line. The closest
+// pair is either found recursively within one of the halves, or it straddles
+// the line, in which case both points lie within a strip of width 2δ around
+// the line, where δ is the smallest distance found in the halves. Sorted by y,
+// each point in the strip only needs to be compared with a constant number of
+// its successors, so the algorithm runs in O(n lg n) time.
+func ClosestPair(points []algebraic.Vector) (algebraic.Vector, algebraic.Vector, float64, error) {
+	if len(points) < 2 {
+		return nil, nil, 0, ErrTooFewPoints
+	}
+
+	for _, p := range points {
+		if len(p) != 2 {
+			return nil, nil, 0, ErrNotPlanar
+		}
+	}
+
+	px := slices.Clone(points)
+	slices.SortFunc(px, compareXY)
+
+	p, q, d := closest(px)
+	return p, q, d, nil
+}
+
+// closest returns the closest pair of points sorted by x. The points are
+// re-sorted by y in place on return, merge sort style, so that the strip can
+// be built in linear time at each level.
+func closest(ps []algebraic.Vector) (algebraic.Vector, algebraic.Vector, float64) {
+	n := len(ps)
+	if n <= 3 {
+		p, q, d := bruteForce(ps)
+		slices.SortFunc(ps, compareY)
+		return p, q, d
+	}
+
+	mid := n / 2
+	midX := ps[mid][0]
+
+	p, q, d := closest(ps[:mid])
+	if rp, rq, rd := closest(ps[mid:]); rd < d {
+		p, q, d = rp, rq, rd
+	}
+
+	// merge the halves, which are now both sorted by y
+	merged := make([]algebraic.Vector, 0, n)
+	i, j := 0, mid
+	for i < mid || j < n {
+		if j == n || (i < mid && compareY(ps[i], ps[j]) <= 0) {
+			merged = append(merged, ps[i])
+			i++
+		} else {
+			merged = append(merged, ps[j])
+			j++
+		}
+	}
+	copy(ps, merged)
+
+	var strip []algebraic.Vector
+	for _, s := range ps {
+		if math.Abs(s[0]-midX) < d {
+			strip = append(strip, s)
+		}
+	}
+
+	for i := range strip {
+		for j := i + 1; j < len(strip) && strip[j][1]-strip[i][1] < d; j++ {
+			if sd, _ := Distance(strip[i], strip[j]); sd < d {
+				p, q, d = strip[i], strip[j], sd
+			}
+		}
+	}
+
+	return p, q, d
+}
+
+// bruteForce returns the closest pair of a small set of points by comparing
+// every pair.
+func bruteForce(ps []algebraic.Vector) (algebraic.Vector, algebraic.Vector, float64) {
+	var p, q algebraic.Vector
+	d := math.Inf(1)
+
+	for i := range ps {
+		for j := i + 1; j < len(ps); j++ {
+			if sd, _ := Distance(ps[i], ps[j]); sd < d {
+				p, q, d = ps[i], ps[j], sd
+			}
+		}
+	}
+
+	return p, q, d
+}
+
+// compareY compares two points by y.
+func compareY(a, b algebraic.Vector) int {
+	switch {
+	case a[1] < b[1]:
+		return -1
+	case a[1] > b[1]:
+		return 1
+	default:
+		return 0
+	}
+}
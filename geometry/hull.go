@@ -0,0 +1,74 @@
+package geometry
+
+import (
+	"slices"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// ConvexHull returns the convex hull of a set of 2-dimensional points, i.e. the
+// smallest convex polygon containing all of them, using Andrew's monotone chain
+// algorithm. The points are sorted lexicographically, after which the lower and
+// upper hulls are built in a single pass each, by discarding the last point of
+// the chain for as long as it does not make a counter-clockwise turn. The hull
+// is returned in counter-clockwise order starting from the lowest leftmost
+// point, without collinear points on its edges. The algorithm runs in
+// O(n lg n) time. Fewer than three distinct or only collinear points result in
+// a degenerate hull of fewer than three vertices. If any point is not
+// 2-dimensional, an error is returned.
+func ConvexHull(points []algebraic.Vector) ([]algebraic.Vector, error) {
+	for _, p := range points {
+		if len(p) != 2 {
+			return nil, ErrNotPlanar
+		}
+	}
+
+	ps := slices.Clone(points)
+	slices.SortFunc(ps, compareXY)
+	ps = slices.CompactFunc(ps, func(a, b algebraic.Vector) bool {
+		return compareXY(a, b) == 0
+	})
+
+	if len(ps) < 3 {
+		return ps, nil
+	}
+
+	hull := make([]algebraic.Vector, 0, 2*len(ps))
+
+	// lower hull
+	for _, p := range ps {
+		for len(hull) >= 2 && Orient2D(hull[len(hull)-2], hull[len(hull)-1], p) != CounterClockwise {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// upper hull
+	lower := len(hull) + 1
+	for i := len(ps) - 2; i >= 0; i-- {
+		p := ps[i]
+		for len(hull) >= lower && Orient2D(hull[len(hull)-2], hull[len(hull)-1], p) != CounterClockwise {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// the last point is the first point repeated
+	return hull[:len(hull)-1], nil
+}
+
+// compareXY compares two points lexicographically, by x and then by y.
+func compareXY(a, b algebraic.Vector) int {
+	switch {
+	case a[0] < b[0]:
+		return -1
+	case a[0] > b[0]:
+		return 1
+	case a[1] < b[1]:
+		return -1
+	case a[1] > b[1]:
+		return 1
+	default:
+		return 0
+	}
+}
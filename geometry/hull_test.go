@@ -0,0 +1,74 @@
+package geometry_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/geometry"
+)
+
+func TestConvexHull(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		points []algebraic.Vector
+		want   []algebraic.Vector
+	}{
+		"should return the corners of a square with interior and edge points": {
+			points: []algebraic.Vector{
+				pt(1, 1), pt(0, 0), pt(2, 0), pt(1, 0), pt(2, 2), pt(0, 2), pt(0, 1), pt(0, 0),
+			},
+			want: []algebraic.Vector{pt(0, 0), pt(2, 0), pt(2, 2), pt(0, 2)},
+		},
+		"should return the endpoints of collinear points": {
+			points: []algebraic.Vector{pt(1, 1), pt(0, 0), pt(2, 2)},
+			want:   []algebraic.Vector{pt(0, 0), pt(2, 2)},
+		},
+		"should return a single point": {
+			points: []algebraic.Vector{pt(1, 1), pt(1, 1)},
+			want:   []algebraic.Vector{pt(1, 1)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := geometry.ConvexHull(test.points)
+			assert.NoError(err)
+			assert.Equal(test.want, got)
+		})
+	}
+
+	_, err := geometry.ConvexHull([]algebraic.Vector{algebraic.NewVector(3, 1, 2, 3)})
+	assert.ErrorIs(err, geometry.ErrNotPlanar)
+}
+
+func TestClosestPair(t *testing.T) {
+	assert := assert.New(t)
+	r := rand.New(rand.NewSource(1))
+
+	var points []algebraic.Vector
+	for range 500 {
+		points = append(points, pt(r.Float64()*1000, r.Float64()*1000))
+	}
+
+	// find the closest pair by brute force
+	want := math.Inf(1)
+	for i := range points {
+		for j := i + 1; j < len(points); j++ {
+			d, _ := geometry.Distance(points[i], points[j])
+			want = math.Min(want, d)
+		}
+	}
+
+	p, q, d, err := geometry.ClosestPair(points)
+	assert.NoError(err)
+	assert.Equal(want, d)
+	pd, _ := geometry.Distance(p, q)
+	assert.Equal(want, pd)
+
+	_, _, _, err = geometry.ClosestPair(points[:1])
+	assert.ErrorIs(err, geometry.ErrTooFewPoints)
+}
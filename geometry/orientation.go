@@ -0,0 +1,194 @@
+package geometry
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Various errors a geometry function can return.
+var (
+	ErrNotPlanar      = errors.New("point is not 2-dimensional")
+	ErrNotSpatial     = errors.New("point is not 3-dimensional")
+	ErrTooFewPoints   = errors.New("too few points given")
+	ErrDegenerate     = errors.New("polygon is degenerate")
+	ErrDimMismatch    = errors.New("points are not of equal dimension")
+	ErrNoIntersection = errors.New("segments do not intersect in a single point")
+)
+
+// Orientation represents the turn direction of an ordered triple of points in
+// the plane, or the side of a plane a point in space lies on.
+type Orientation int
+
+const (
+	Clockwise        Orientation = -1
+	Collinear        Orientation = 0
+	CounterClockwise Orientation = 1
+)
+
+// String returns the name of the orientation.
+func (o Orientation) String() string {
+	switch o {
+	case Clockwise:
+		return "clockwise"
+	case CounterClockwise:
+		return "counter-clockwise"
+	default:
+		return "collinear"
+	}
+}
+
+// epsilon is half the machine epsilon of float64, i.e. the maximum relative
+// rounding error of a single floating point operation.
+const epsilon = 1.1102230246251565e-16
+
+// Orient2D returns the orientation of the points a, b and c in the plane, i.e.
+// the sign of the determinant
+//
+//	| a.x-c.x  a.y-c.y |
+//	| b.x-c.x  b.y-c.y |
+//
+// The predicate is robust: the determinant is first computed in floating point,
+// and only if its magnitude is within the bound of the accumulated rounding
+// error, it is recomputed in exact rational arithmetic. Thus the result is
+// always exact, while most calls only cost a few floating point operations. The
+// points must be 2-dimensional, otherwise the result is collinear. The
+// orientation is undefined if any coordinate is infinite or NaN, in which case
+// the result is collinear as well.
+func Orient2D(a, b, c algebraic.Vector) Orientation {
+	if len(a) != 2 || len(b) != 2 || len(c) != 2 {
+		return Collinear
+	}
+
+	l := (a[0] - c[0]) * (b[1] - c[1])
+	r := (a[1] - c[1]) * (b[0] - c[0])
+	det := l - r
+
+	// error bound from Shewchuk's adaptive predicates
+	bound := (3 + 16*epsilon) * epsilon * (math.Abs(l) + math.Abs(r))
+	if det > bound || -det > bound {
+		return sign(det)
+	}
+
+	return exactSign(
+		[]float64{a[0], a[1]},
+		[]float64{b[0], b[1]},
+		[]float64{c[0], c[1]},
+	)
+}
+
+// Orient3D returns the orientation of the point d relative to the plane through
+// a, b and c in space. The result is counter-clockwise if d lies below the
+// plane, i.e. a, b and c appear in counter-clockwise order when viewed from
+// above, and clockwise if d lies above it. As Orient2D, the predicate is
+// robust. The points must be 3-dimensional, otherwise the result is coplanar,
+// i.e. collinear. As for Orient2D, the result is collinear if any coordinate is
+// infinite or NaN.
+func Orient3D(a, b, c, d algebraic.Vector) Orientation {
+	if len(a) != 3 || len(b) != 3 || len(c) != 3 || len(d) != 3 {
+		return Collinear
+	}
+
+	ax, ay, az := a[0]-d[0], a[1]-d[1], a[2]-d[2]
+	bx, by, bz := b[0]-d[0], b[1]-d[1], b[2]-d[2]
+	cx, cy, cz := c[0]-d[0], c[1]-d[1], c[2]-d[2]
+
+	det := ax*(by*cz-bz*cy) + ay*(bz*cx-bx*cz) + az*(bx*cy-by*cx)
+	perm := math.Abs(ax)*(math.Abs(by*cz)+math.Abs(bz*cy)) +
+		math.Abs(ay)*(math.Abs(bz*cx)+math.Abs(bx*cz)) +
+		math.Abs(az)*(math.Abs(bx*cy)+math.Abs(by*cx))
+
+	// error bound from Shewchuk's adaptive predicates
+	bound := (7 + 56*epsilon) * epsilon * perm
+	if det > bound || -det > bound {
+		return sign(det)
+	}
+
+	return exactSign(a, b, c, d)
+}
+
+// exactSign returns the sign of the orientation determinant of the given
+// points computed in exact rational arithmetic. Given n+1 points of dimension
+// n, the determinant is that of the n×n matrix of the first n points relative to
+// the last. Infinite and NaN coordinates have no rational value, so for those
+// the result is collinear.
+func exactSign(ps ...[]float64) Orientation {
+	for _, p := range ps {
+		for _, c := range p {
+			if math.IsInf(c, 0) || math.IsNaN(c) {
+				return Collinear
+			}
+		}
+	}
+
+	n := len(ps) - 1
+	last := ps[n]
+
+	m := make([][]*big.Rat, n)
+	for i := range n {
+		m[i] = make([]*big.Rat, n)
+		for j := range n {
+			p := new(big.Rat).SetFloat64(ps[i][j])
+			q := new(big.Rat).SetFloat64(last[j])
+			m[i][j] = p.Sub(p, q)
+		}
+	}
+
+	return Orientation(bigDet(m).Sign())
+}
+
+// bigDet returns the determinant of a small square matrix of rationals by
+// cofactor expansion along the first row.
+func bigDet(m [][]*big.Rat) *big.Rat {
+	n := len(m)
+	if n == 1 {
+		return m[0][0]
+	}
+
+	det := new(big.Rat)
+	for j := range n {
+		minor := make([][]*big.Rat, n-1)
+		for i := 1; i < n; i++ {
+			for k := range n {
+				if k != j {
+					minor[i-1] = append(minor[i-1], m[i][k])
+				}
+			}
+		}
+
+		t := new(big.Rat).Mul(m[0][j], bigDet(minor))
+		if j%2 == 0 {
+			det.Add(det, t)
+		} else {
+			det.Sub(det, t)
+		}
+	}
+
+	return det
+}
+
+// sign returns the sign of a float as an orientation.
+func sign(f float64) Orientation {
+	switch {
+	case f > 0:
+		return CounterClockwise
+	case f < 0:
+		return Clockwise
+	default:
+		return Collinear
+	}
+}
+
+// Distance returns the Euclidean distance between points p and q of any equal
+// dimension.
+func Distance(p, q algebraic.Vector) (float64, error) {
+	if len(p) != len(q) {
+		return 0, ErrDimMismatch
+	}
+
+	d := algebraic.NewVector(p.Dimension(), p...)
+	d.Sub(q)
+	return d.Magnitude(), nil
+}
@@ -0,0 +1,123 @@
+package geometry_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/geometry"
+)
+
+// pt returns a new 2-dimensional point.
+func pt(x, y float64) algebraic.Vector {
+	return algebraic.NewVector(2, x, y)
+}
+
+func TestOrient2D(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		a, b, c algebraic.Vector
+		want    geometry.Orientation
+	}{
+		"should return counter-clockwise for a left turn": {
+			a:    pt(0, 0),
+			b:    pt(1, 0),
+			c:    pt(1, 1),
+			want: geometry.CounterClockwise,
+		},
+		"should return clockwise for a right turn": {
+			a:    pt(0, 0),
+			b:    pt(1, 0),
+			c:    pt(1, -1),
+			want: geometry.Clockwise,
+		},
+		"should return collinear for points on a line": {
+			a:    pt(0, 0),
+			b:    pt(1, 1),
+			c:    pt(3, 3),
+			want: geometry.Collinear,
+		},
+		"should return collinear for nearly collinear points where floating point fails": {
+			a:    pt(0.5, 0.5),
+			b:    pt(12, 12),
+			c:    pt(24, 24),
+			want: geometry.Collinear,
+		},
+		"should detect a tiny left turn": {
+			a:    pt(0.5, 0.5),
+			b:    pt(12, 12),
+			c:    pt(24, math.Nextafter(24, 25)),
+			want: geometry.CounterClockwise,
+		},
+		"should detect a tiny right turn": {
+			a:    pt(0.5, 0.5),
+			b:    pt(12, 12),
+			c:    pt(math.Nextafter(24, 25), 24),
+			want: geometry.Clockwise,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := geometry.Orient2D(test.a, test.b, test.c)
+			assert.Equal(test.want, got)
+		})
+	}
+}
+
+func TestOrient3D(t *testing.T) {
+	assert := assert.New(t)
+	a := algebraic.NewVector(3, 0, 0, 0)
+	b := algebraic.NewVector(3, 1, 0, 0)
+	c := algebraic.NewVector(3, 0, 1, 0)
+
+	assert.Equal(geometry.CounterClockwise, geometry.Orient3D(a, b, c, algebraic.NewVector(3, 0, 0, -1)))
+	assert.Equal(geometry.Clockwise, geometry.Orient3D(a, b, c, algebraic.NewVector(3, 0, 0, 1)))
+	assert.Equal(geometry.Collinear, geometry.Orient3D(a, b, c, algebraic.NewVector(3, 5, 7, 0)))
+}
+
+func TestOrientNonFinite(t *testing.T) {
+	assert := assert.New(t)
+	inf, nan := math.Inf(1), math.NaN()
+	tests := map[string]struct {
+		p algebraic.Vector
+	}{
+		"should return collinear for an infinite coordinate": {
+			p: pt(inf, 1),
+		},
+		"should return collinear for a negative infinite coordinate": {
+			p: pt(1, math.Inf(-1)),
+		},
+		"should return collinear for a NaN coordinate": {
+			p: pt(nan, 1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(geometry.Collinear, geometry.Orient2D(pt(0, 0), pt(1, 0), test.p))
+			assert.Equal(geometry.Collinear, geometry.Orient2D(test.p, pt(0, 0), pt(1, 0)))
+
+			q := algebraic.NewVector(3, test.p[0], test.p[1], 0)
+			a := algebraic.NewVector(3, 0, 0, 0)
+			b := algebraic.NewVector(3, 1, 0, 0)
+			c := algebraic.NewVector(3, 0, 1, 0)
+			assert.Equal(geometry.Collinear, geometry.Orient3D(a, b, c, q))
+
+			// predicates built on the orientation do not panic either
+			assert.NotPanics(func() {
+				s := geometry.Segment{A: pt(0, 0), B: test.p}
+				s.Intersects(geometry.Segment{A: pt(0, 1), B: pt(1, 0)})
+				_, _ = s.Intersection(geometry.Segment{A: pt(0, 1), B: pt(1, 0)})
+
+				sq := geometry.Polygon{pt(0, 0), pt(2, 0), pt(2, 2), pt(0, 2)}
+				sq.Contains(test.p)
+				geometry.Polygon{pt(0, 0), test.p, pt(0, 2)}.IsConvex()
+
+				_, _ = geometry.ConvexHull([]algebraic.Vector{pt(0, 0), pt(2, 0), test.p, pt(0, 2)})
+			})
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package geometry
+
+import (
+	"math"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Polygon defines a simple polygon in the plane by its vertices in order. The
+// polygon is implicitly closed, i.e. the last vertex connects to the first.
+type Polygon []algebraic.Vector
+
+// NewPolygon creates a new instance of a Polygon from a slice of vertices. At
+// least three 2-dimensional vertices are required, otherwise an error is
+// returned.
+func NewPolygon(vs ...algebraic.Vector) (Polygon, error) {
+	if len(vs) < 3 {
+		return nil, ErrTooFewPoints
+	}
+
+	for _, v := range vs {
+		if len(v) != 2 {
+			return nil, ErrNotPlanar
+		}
+	}
+
+	return Polygon(vs), nil
+}
+
+// SignedArea returns the signed area of the polygon using the shoelace
+// formula. The area is positive if the vertices are in counter-clockwise order,
+// and negative if they are in clockwise order.
+func (p Polygon) SignedArea() float64 {
+	var a float64
+	for i := range p {
+		j := (i + 1) % len(p)
+		a += p[i][0]*p[j][1] - p[j][0]*p[i][1]
+	}
+
+	return a / 2
+}
+
+// Area returns the area of the polygon.
+func (p Polygon) Area() float64 {
+	return math.Abs(p.SignedArea())
+}
+
+// Perimeter returns the total length of the edges of the polygon.
+func (p Polygon) Perimeter() float64 {
+	var l float64
+	for i := range p {
+		d, _ := Distance(p[i], p[(i+1)%len(p)])
+		l += d
+	}
+
+	return l
+}
+
+// Centroid returns the center of mass of the polygon, assuming uniform density.
+// If the polygon has zero area, an error is returned.
+func (p Polygon) Centroid() (algebraic.Vector, error) {
+	var a, cx, cy float64
+	for i := range p {
+		j := (i + 1) % len(p)
+		cross := p[i][0]*p[j][1] - p[j][0]*p[i][1]
+		a += cross
+		cx += (p[i][0] + p[j][0]) * cross
+		cy += (p[i][1] + p[j][1]) * cross
+	}
+
+	if a == 0 {
+		return nil, ErrDegenerate
+	}
+
+	return algebraic.NewVector(2, cx/(3*a), cy/(3*a)), nil
+}
+
+// Edges returns the edges of the polygon as segments.
+func (p Polygon) Edges() []Segment {
+	es := make([]Segment, len(p))
+	for i := range p {
+		es[i] = Segment{A: p[i], B: p[(i+1)%len(p)]}
+	}
+
+	return es
+}
+
+// Contains checks if a point q lies inside the polygon or on its boundary. The
+// test computes the winding number of the polygon around q, i.e. the number of
+// times the boundary winds counter-clockwise around q, by counting signed
+// crossings of a ray from q. A nonzero winding number means q is inside. As
+// only orientation predicates are used, the test is exact.
+func (p Polygon) Contains(q algebraic.Vector) bool {
+	var wn int
+
+	for _, e := range p.Edges() {
+		if e.Contains(q) {
+			return true
+		}
+
+		if e.A[1] <= q[1] {
+			// an upward crossing with q left of the edge
+			if e.B[1] > q[1] && Orient2D(e.A, e.B, q) == CounterClockwise {
+				wn++
+			}
+		} else {
+			// a downward crossing with q right of the edge
+			if e.B[1] <= q[1] && Orient2D(e.A, e.B, q) == Clockwise {
+				wn--
+			}
+		}
+	}
+
+	return wn != 0
+}
+
+// IsConvex checks if the polygon is convex, i.e. all consecutive triples of
+// vertices turn in the same direction.
+func (p Polygon) IsConvex() bool {
+	var turn Orientation
+
+	for i := range p {
+		o := Orient2D(p[i], p[(i+1)%len(p)], p[(i+2)%len(p)])
+		if o == Collinear {
+			continue
+		}
+
+		if turn != Collinear && o != turn {
+			return false
+		}
+
+		turn = o
+	}
+
+	return true
+}
@@ -0,0 +1,56 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/geometry"
+)
+
+func TestPolygon(t *testing.T) {
+	assert := assert.New(t)
+
+	// an L-shaped polygon in counter-clockwise order
+	p, err := geometry.NewPolygon(pt(0, 0), pt(2, 0), pt(2, 1), pt(1, 1), pt(1, 2), pt(0, 2))
+	assert.NoError(err)
+
+	assert.Equal(3.0, p.SignedArea())
+	assert.Equal(3.0, p.Area())
+	assert.Equal(8.0, p.Perimeter())
+	assert.False(p.IsConvex())
+
+	c, err := p.Centroid()
+	assert.NoError(err)
+	assert.InDeltaSlice(pt(5.0/6, 5.0/6), c, 1e-12)
+
+	tests := map[string]struct {
+		q    algebraic.Vector
+		want bool
+	}{
+		"should contain an interior point":        {q: pt(0.5, 0.5), want: true},
+		"should contain a point on an edge":       {q: pt(1.5, 1), want: true},
+		"should contain a vertex":                 {q: pt(1, 1), want: true},
+		"should not contain a point in the notch": {q: pt(1.5, 1.5), want: false},
+		"should not contain an exterior point":    {q: pt(-1, 0), want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.want, p.Contains(test.q))
+		})
+	}
+}
+
+func TestPolygonErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := geometry.NewPolygon(pt(0, 0), pt(1, 0))
+	assert.ErrorIs(err, geometry.ErrTooFewPoints)
+
+	p, err := geometry.NewPolygon(pt(0, 0), pt(1, 1), pt(2, 2))
+	assert.NoError(err)
+	_, err = p.Centroid()
+	assert.ErrorIs(err, geometry.ErrDegenerate)
+}
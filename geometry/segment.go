@@ -0,0 +1,107 @@
+package geometry
+
+import (
+	"math"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Segment defines a line segment in the plane between the points A and B.
+type Segment struct {
+	A, B algebraic.Vector
+}
+
+// NewSegment creates a new instance of a Segment between two 2-dimensional
+// points. If either point is not 2-dimensional, an error is returned.
+func NewSegment(a, b algebraic.Vector) (Segment, error) {
+	if len(a) != 2 || len(b) != 2 {
+		return Segment{}, ErrNotPlanar
+	}
+
+	return Segment{A: a, B: b}, nil
+}
+
+// Length returns the length of the segment.
+func (s Segment) Length() float64 {
+	d, _ := Distance(s.A, s.B)
+	return d
+}
+
+// onSegment checks if a point p, known to be collinear with the segment, lies
+// within the bounding box of the segment, and thereby on the segment.
+func (s Segment) onSegment(p algebraic.Vector) bool {
+	return math.Min(s.A[0], s.B[0]) <= p[0] && p[0] <= math.Max(s.A[0], s.B[0]) &&
+		math.Min(s.A[1], s.B[1]) <= p[1] && p[1] <= math.Max(s.A[1], s.B[1])
+}
+
+// Contains checks if a point p lies on the segment, including its endpoints.
+func (s Segment) Contains(p algebraic.Vector) bool {
+	return Orient2D(s.A, s.B, p) == Collinear && s.onSegment(p)
+}
+
+// Intersects checks if segment s and segment t share at least one point. Two
+// segments intersect if each straddles the line containing the other, i.e. the
+// endpoints of one lie on opposite sides of the other. The boundary cases, where
+// an endpoint lies on the other segment, are handled separately. As only
+// orientation predicates are used, the test is exact.
+func (s Segment) Intersects(t Segment) bool {
+	d1 := Orient2D(t.A, t.B, s.A)
+	d2 := Orient2D(t.A, t.B, s.B)
+	d3 := Orient2D(s.A, s.B, t.A)
+	d4 := Orient2D(s.A, s.B, t.B)
+
+	if d1*d2 < 0 && d3*d4 < 0 {
+		return true
+	}
+
+	return (d1 == Collinear && t.onSegment(s.A)) ||
+		(d2 == Collinear && t.onSegment(s.B)) ||
+		(d3 == Collinear && s.onSegment(t.A)) ||
+		(d4 == Collinear && s.onSegment(t.B))
+}
+
+// Intersection returns the point where segment s and segment t intersect. If
+// the segments do not intersect, or overlap along a common line so that there
+// is no single intersection point, an error is returned.
+func (s Segment) Intersection(t Segment) (algebraic.Vector, error) {
+	if !s.Intersects(t) {
+		return nil, ErrNoIntersection
+	}
+
+	rx, ry := s.B[0]-s.A[0], s.B[1]-s.A[1]
+	qx, qy := t.B[0]-t.A[0], t.B[1]-t.A[1]
+	den := rx*qy - ry*qx
+
+	if den == 0 {
+		// parallel segments that intersect are collinear, and only have a
+		// single common point if they touch at an endpoint
+		if s.overlaps(t) {
+			return nil, ErrNoIntersection
+		}
+
+		for _, p := range []algebraic.Vector{s.A, s.B, t.A, t.B} {
+			if s.Contains(p) && t.Contains(p) {
+				return algebraic.NewVector(2, p...), nil
+			}
+		}
+
+		return nil, ErrNoIntersection
+	}
+
+	u := ((t.A[0]-s.A[0])*qy - (t.A[1]-s.A[1])*qx) / den
+	return algebraic.NewVector(2, s.A[0]+u*rx, s.A[1]+u*ry), nil
+}
+
+// overlaps checks if two collinear segments share more than a single point, by
+// projecting them onto the direction of s.
+func (s Segment) overlaps(t Segment) bool {
+	rx, ry := s.B[0]-s.A[0], s.B[1]-s.A[1]
+	proj := func(p algebraic.Vector) float64 {
+		return (p[0]-s.A[0])*rx + (p[1]-s.A[1])*ry
+	}
+
+	lo := math.Max(0, math.Min(proj(t.A), proj(t.B)))
+	hi := math.Min(rx*rx+ry*ry, math.Max(proj(t.A), proj(t.B)))
+
+	return hi > lo
+}
@@ -0,0 +1,80 @@
+package geometry_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/geometry"
+)
+
+func TestSegmentIntersection(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		s, t           geometry.Segment
+		wantIntersects bool
+		want           algebraic.Vector
+		wantErr        error
+	}{
+		"should intersect crossing segments": {
+			s:              geometry.Segment{A: pt(0, 0), B: pt(2, 2)},
+			t:              geometry.Segment{A: pt(0, 2), B: pt(2, 0)},
+			wantIntersects: true,
+			want:           pt(1, 1),
+		},
+		"should intersect segments touching at an endpoint": {
+			s:              geometry.Segment{A: pt(0, 0), B: pt(1, 1)},
+			t:              geometry.Segment{A: pt(1, 1), B: pt(2, 0)},
+			wantIntersects: true,
+			want:           pt(1, 1),
+		},
+		"should intersect collinear segments touching at an endpoint": {
+			s:              geometry.Segment{A: pt(0, 0), B: pt(1, 1)},
+			t:              geometry.Segment{A: pt(1, 1), B: pt(2, 2)},
+			wantIntersects: true,
+			want:           pt(1, 1),
+		},
+		"should intersect overlapping collinear segments without a single point": {
+			s:              geometry.Segment{A: pt(0, 0), B: pt(2, 2)},
+			t:              geometry.Segment{A: pt(1, 1), B: pt(3, 3)},
+			wantIntersects: true,
+			wantErr:        geometry.ErrNoIntersection,
+		},
+		"should not intersect parallel segments": {
+			s:       geometry.Segment{A: pt(0, 0), B: pt(2, 0)},
+			t:       geometry.Segment{A: pt(0, 1), B: pt(2, 1)},
+			wantErr: geometry.ErrNoIntersection,
+		},
+		"should not intersect disjoint collinear segments": {
+			s:       geometry.Segment{A: pt(0, 0), B: pt(1, 0)},
+			t:       geometry.Segment{A: pt(2, 0), B: pt(3, 0)},
+			wantErr: geometry.ErrNoIntersection,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.wantIntersects, test.s.Intersects(test.t))
+
+			got, err := test.s.Intersection(test.t)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.InDeltaSlice(test.want, got, 1e-12)
+			}
+		})
+	}
+}
+
+func TestNewSegment(t *testing.T) {
+	assert := assert.New(t)
+
+	s, err := geometry.NewSegment(pt(0, 0), pt(3, 4))
+	assert.NoError(err)
+	assert.Equal(5.0, s.Length())
+
+	_, err = geometry.NewSegment(pt(0, 0), algebraic.NewVector(3, 1, 2, 3))
+	assert.ErrorIs(err, geometry.ErrNotPlanar)
+}
@@ -2,6 +2,7 @@ package hashtable
 
 import (
 	"errors"
+	"iter"
 
 	"github.com/madshov/data-structures/elementary"
 )
@@ -82,6 +83,21 @@ func (ht *HashTable) Delete(key string) error {
 	return nil
 }
 
+// All returns an iterator over the keys and values in the hash table. The
+// pairs are visited slot by slot, so the order depends on the hashing
+// mechanism and is not the order of insertion.
+func (ht *HashTable) All() iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		for _, l := range ht.list {
+			for k, v := range l.All() {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // Hasher is the hasher used for the hash table operations.
 type Hasher interface {
 	Hash(string) int
@@ -0,0 +1,56 @@
+package hashtable_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/hashtable"
+)
+
+func TestHashTableAll(t *testing.T) {
+	assert := assert.New(t)
+	ht := hashtable.New(7, nil)
+	want := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5, "f": 6}
+	for k, v := range want {
+		assert.NoError(ht.Insert(k, v))
+	}
+
+	got := make(map[string]int)
+	for k, v := range ht.All() {
+		got[k] = v
+	}
+	assert.Equal(want, got)
+
+	// stop early, after the first two pairs
+	var n int
+	for range ht.All() {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	assert.Equal(2, n)
+
+	// the same sequence can be ranged over again, in the same order
+	seq := ht.All()
+	var first, second []string
+	for k := range seq {
+		first = append(first, k)
+	}
+	for k := range seq {
+		second = append(second, k)
+	}
+	assert.Equal(first, second)
+	assert.Len(first, len(want))
+
+	// deleted pairs are not visited
+	assert.NoError(ht.Delete("c"))
+	for k := range ht.All() {
+		assert.NotEqual("c", k)
+	}
+
+	for range hashtable.New(3, nil).All() {
+		assert.Fail("empty hash table yielded a pair")
+	}
+}
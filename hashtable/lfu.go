@@ -0,0 +1,197 @@
+package hashtable
+
+import (
+	"github.com/madshov/data-structures/elementary"
+)
+
+// NewLFUCache creates a new instance of an LFU cache with a given capacity, and
+// an optional callback, which is called with the key and value of each entry
+// evicted from the cache. An LFU (least frequently used) cache holds at most
+// capacity entries, and once full, makes room for a new entry by evicting the
+// entry that was used the fewest times. Ties are broken by recency, i.e. among
+// the least frequently used entries, the least recently used one is evicted.
+//
+// A straightforward LFU cache keeps the entries in a heap ordered by use
+// count, which makes each operation take O(lg n) time. Instead, the entries
+// are grouped into buckets of equal use count, and the buckets are kept in a
+// doubly linked list ordered by increasing count. Each bucket is itself a list
+// of entries ordered by recency, and a map from keys gives the entry and the
+// bucket it belongs to. As for LRUCache, the map is a Go map rather than a
+// HashTable. When an entry is used, it moves from its bucket with count f to
+// the bucket with count f+1, which is either the next bucket in the list or a
+// new bucket inserted right after it. The entry to evict is always the tail of
+// the head bucket, so all operations run in O(1) time. If the capacity is less
+// than 1, an error is returned.
+func NewLFUCache[K comparable, V any](capacity int, onEvict func(K, V)) (*LFUCache[K, V], error) {
+	if capacity < 1 {
+		return nil, ErrInvalidCapacity
+	}
+
+	return &LFUCache[K, V]{
+		buckets:  elementary.NewListOf[int, *elementary.ListOf[K, V]](),
+		index:    make(map[K]*lfuEntry[K, V], capacity),
+		capacity: capacity,
+		onEvict:  onEvict,
+	}, nil
+}
+
+// LFUCache defines an LFU cache structure with a list of buckets of entries
+// keyed by use count, an index of the entries by key, a capacity, an eviction
+// callback and statistics.
+type LFUCache[K comparable, V any] struct {
+	buckets  *elementary.ListOf[int, *elementary.ListOf[K, V]]
+	index    map[K]*lfuEntry[K, V]
+	capacity int
+	onEvict  func(K, V)
+	stats    CacheStats
+}
+
+// lfuEntry defines the position of an entry in the cache, i.e. its element in
+// a bucket, and the bucket's element in the list of buckets.
+type lfuEntry[K comparable, V any] struct {
+	el     *elementary.ListElementOf[K, V]
+	bucket *elementary.ListElementOf[int, *elementary.ListOf[K, V]]
+}
+
+// Len returns the total number of entries in the cache.
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.index)
+}
+
+// Cap returns the capacity of the cache.
+func (c *LFUCache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the hit, miss and eviction statistics of the cache.
+func (c *LFUCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// Frequency returns the use count of an entry with a given key, or 0 if it
+// does not exist. An entry is used once when it is added, and once every time
+// it is looked up or updated.
+func (c *LFUCache[K, V]) Frequency(key K) int {
+	e, exists := c.index[key]
+	if !exists {
+		return 0
+	}
+
+	return e.bucket.Key
+}
+
+// Get looks for an entry with a given key and, if it exists, increments its
+// use count and returns its value.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	e, exists := c.index[key]
+	if !exists {
+		c.stats.Misses++
+
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.touch(e)
+
+	return e.el.Value, true
+}
+
+// Peek looks for an entry with a given key and, if it exists, returns its
+// value without incrementing its use count or counting the lookup in the
+// statistics.
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	e, exists := c.index[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	return e.el.Value, true
+}
+
+// Put adds an entry with a given key and value to the cache with a use count
+// of 1, or updates the value and increments the use count if the key already
+// exists. If the cache is full, the least frequently used entry is evicted.
+func (c *LFUCache[K, V]) Put(key K, val V) {
+	if e, exists := c.index[key]; exists {
+		e.el.Value = val
+		c.touch(e)
+		return
+	}
+
+	if len(c.index) >= c.capacity {
+		c.evict()
+	}
+
+	b := c.buckets.Front()
+	if b == nil || b.Key != 1 {
+		b = c.buckets.PushFront(1, elementary.NewListOf[K, V]())
+	}
+
+	c.index[key] = &lfuEntry[K, V]{
+		el:     b.Value.PushFront(key, val),
+		bucket: b,
+	}
+}
+
+// Remove looks for an entry with a given key and, if it exists, removes it
+// from the cache. The eviction callback is not called for removed entries.
+func (c *LFUCache[K, V]) Remove(key K) error {
+	e, exists := c.index[key]
+	if !exists {
+		return ErrNonExistingKey
+	}
+
+	delete(c.index, key)
+	if err := c.unlink(e); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// touch increments the use count of an entry, by moving it from its bucket to
+// the head of the bucket with the next count.
+func (c *LFUCache[K, V]) touch(e *lfuEntry[K, V]) {
+	b := e.bucket
+	next := b.Next()
+	if next == nil || next.Key != b.Key+1 {
+		next, _ = c.buckets.InsertAfter(b.Key+1, elementary.NewListOf[K, V](), b)
+	}
+
+	key, val := e.el.Key, e.el.Value
+	c.unlink(e)
+
+	e.el = next.Value.PushFront(key, val)
+	e.bucket = next
+}
+
+// unlink removes an entry from its bucket, and removes the bucket if it
+// becomes empty.
+func (c *LFUCache[K, V]) unlink(e *lfuEntry[K, V]) error {
+	if err := e.bucket.Value.Delete(e.el); err != nil {
+		return err
+	}
+
+	if e.bucket.Value.IsEmpty() {
+		return c.buckets.Delete(e.bucket)
+	}
+
+	return nil
+}
+
+// evict removes the least recently used of the least frequently used entries
+// from the cache.
+func (c *LFUCache[K, V]) evict() {
+	el := c.buckets.Front().Value.Back()
+	e := c.index[el.Key]
+
+	delete(c.index, el.Key)
+	c.unlink(e)
+	c.stats.Evictions++
+
+	if c.onEvict != nil {
+		c.onEvict(el.Key, el.Value)
+	}
+}
@@ -0,0 +1,220 @@
+package hashtable_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/hashtable"
+)
+
+func TestNewLFUCache(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		capacity int
+		err      error
+	}{
+		"should return error for a negative capacity": {
+			capacity: -1,
+			err:      hashtable.ErrInvalidCapacity,
+		},
+		"should return error for a capacity of 0": {
+			capacity: 0,
+			err:      hashtable.ErrInvalidCapacity,
+		},
+		"should create a cache of capacity 1": {
+			capacity: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := hashtable.NewLFUCache[string, int](test.capacity, nil)
+			assert.Equal(test.err, err)
+			if err != nil {
+				assert.Nil(c)
+				return
+			}
+
+			assert.Equal(test.capacity, c.Cap())
+			assert.Equal(0, c.Len())
+		})
+	}
+}
+
+func TestLFUCacheEviction(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		ops     func(c *hashtable.LFUCache[string, int])
+		evicted []string
+		kept    []string
+	}{
+		"should evict nothing below capacity": {
+			ops: func(c *hashtable.LFUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+			},
+			kept: []string{"a", "b"},
+		},
+		"should evict the least frequently used entry": {
+			ops: func(c *hashtable.LFUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Get("a")
+				c.Get("b")
+				c.Get("a")
+				c.Put("d", 4)
+			},
+			evicted: []string{"c"},
+			kept:    []string{"a", "b", "d"},
+		},
+		"should break ties by evicting the least recently used entry": {
+			ops: func(c *hashtable.LFUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Get("c")
+				c.Get("a")
+				c.Get("b")
+				c.Put("d", 4)
+				c.Put("e", 5)
+			},
+			evicted: []string{"c", "d"},
+			kept:    []string{"a", "b", "e"},
+		},
+		"should count updates as uses": {
+			ops: func(c *hashtable.LFUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Put("a", 10)
+				c.Put("b", 20)
+				c.Put("d", 4)
+			},
+			evicted: []string{"c"},
+			kept:    []string{"a", "b", "d"},
+		},
+		"should not count peeks as uses": {
+			ops: func(c *hashtable.LFUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Peek("a")
+				c.Get("b")
+				c.Get("c")
+				c.Put("d", 4)
+			},
+			evicted: []string{"a"},
+			kept:    []string{"b", "c", "d"},
+		},
+		"should evict a new entry before a frequently used one": {
+			ops: func(c *hashtable.LFUCache[string, int]) {
+				c.Put("a", 1)
+				for range 5 {
+					c.Get("a")
+				}
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Put("d", 4)
+				c.Put("e", 5)
+			},
+			evicted: []string{"b", "c"},
+			kept:    []string{"a", "d", "e"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var ev evicted
+			c, _ := hashtable.NewLFUCache(3, ev.onEvict)
+			test.ops(c)
+
+			assert.Equal(test.evicted, ev.keys)
+			assert.Equal(len(test.evicted), c.Stats().Evictions)
+			assert.Equal(len(test.kept), c.Len())
+			for _, k := range test.kept {
+				_, ok := c.Peek(k)
+				assert.True(ok, "key %s", k)
+			}
+		})
+	}
+}
+
+func TestLFUCacheFrequency(t *testing.T) {
+	assert := assert.New(t)
+	var ev evicted
+	c, _ := hashtable.NewLFUCache(2, ev.onEvict)
+	assert.Equal(0, c.Frequency("a"))
+
+	c.Put("a", 1)
+	assert.Equal(1, c.Frequency("a"))
+
+	c.Get("a")
+	c.Put("a", 2)
+	c.Peek("a")
+	assert.Equal(3, c.Frequency("a"))
+
+	v, ok := c.Get("a")
+	assert.True(ok)
+	assert.Equal(2, v)
+	assert.Equal(4, c.Frequency("a"))
+
+	// the evicted value is passed to the callback
+	c.Put("b", 3)
+	c.Put("c", 4)
+	assert.Equal([]string{"b"}, ev.keys)
+	assert.Equal([]int{3}, ev.vals)
+	assert.Equal(0, c.Frequency("b"))
+	assert.Equal(1, c.Frequency("c"))
+}
+
+func TestLFUCacheStats(t *testing.T) {
+	assert := assert.New(t)
+	c, _ := hashtable.NewLFUCache[string, int](2, nil)
+	assert.Equal(hashtable.CacheStats{}, c.Stats())
+	assert.Equal(0.0, c.Stats().HitRate())
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("a")
+	c.Get("x")
+	c.Peek("b")
+	c.Peek("y")
+	c.Put("c", 3)
+
+	assert.Equal(hashtable.CacheStats{Hits: 3, Misses: 1, Evictions: 1}, c.Stats())
+	assert.Equal(0.75, c.Stats().HitRate())
+}
+
+func TestLFUCacheRemove(t *testing.T) {
+	assert := assert.New(t)
+	var ev evicted
+	c, _ := hashtable.NewLFUCache(2, ev.onEvict)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+
+	assert.NoError(c.Remove("a"))
+	assert.Equal(hashtable.ErrNonExistingKey, c.Remove("a"))
+	assert.Equal(hashtable.ErrNonExistingKey, c.Remove("x"))
+	assert.Equal(1, c.Len())
+
+	// removed entries are not passed to the callback
+	assert.Nil(ev.keys)
+	assert.Equal(0, c.Stats().Evictions)
+
+	// a removed key is added again with a use count of 1
+	c.Put("a", 3)
+	assert.Equal(1, c.Frequency("a"))
+
+	// removing the only entry of a bucket leaves the other buckets intact
+	c.Get("b")
+	assert.NoError(c.Remove("a"))
+	c.Put("c", 4)
+	c.Put("d", 5)
+	assert.Equal([]string{"c"}, ev.keys)
+	assert.Equal(2, c.Frequency("b"))
+}
@@ -0,0 +1,169 @@
+package hashtable
+
+import (
+	"errors"
+
+	"github.com/madshov/data-structures/elementary"
+)
+
+// Various errors a cache function can return.
+var (
+	ErrInvalidCapacity = errors.New("cache capacity must be at least 1")
+)
+
+// CacheStats defines the number of hits, misses and evictions of a cache.
+type CacheStats struct {
+	Hits      int
+	Misses    int
+	Evictions int
+}
+
+// HitRate returns the fraction of lookups that were hits, or 0 if no lookups
+// have been made.
+func (s CacheStats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// NewLRUCache creates a new instance of an LRU cache with a given capacity, and
+// an optional callback, which is called with the key and value of each entry
+// evicted from the cache. An LRU (least recently used) cache holds at most
+// capacity entries, and once full, makes room for a new entry by evicting the
+// entry that was used least recently. The cache is a doubly linked list of
+// entries ordered by recency, i.e. the head of the list is the most recently
+// used entry and the tail is the least recently used entry, combined with a
+// map from keys to list elements. Looking up an entry finds its element in the
+// map and moves it to the head of the list, while evicting an entry removes
+// the tail of the list, so all operations run in O(1) time. If the capacity is
+// less than 1, an error is returned.
+//
+// The index is a Go map rather than a HashTable, as HashTable only maps string
+// keys to int values, whereas the index maps keys of any comparable type to
+// list elements. Further, HashTable has a fixed number of slots and never
+// rehashes, so its lookups degrade to O(n) time as it fills up, whereas a Go
+// map grows with its contents and keeps lookups at O(1) expected time.
+func NewLRUCache[K comparable, V any](capacity int, onEvict func(K, V)) (*LRUCache[K, V], error) {
+	if capacity < 1 {
+		return nil, ErrInvalidCapacity
+	}
+
+	return &LRUCache[K, V]{
+		list:     elementary.NewListOf[K, V](),
+		index:    make(map[K]*elementary.ListElementOf[K, V], capacity),
+		capacity: capacity,
+		onEvict:  onEvict,
+	}, nil
+}
+
+// LRUCache defines an LRU cache structure with a list of entries ordered by
+// recency, an index of the entries by key, a capacity, an eviction callback
+// and statistics.
+type LRUCache[K comparable, V any] struct {
+	list     *elementary.ListOf[K, V]
+	index    map[K]*elementary.ListElementOf[K, V]
+	capacity int
+	onEvict  func(K, V)
+	stats    CacheStats
+}
+
+// Len returns the total number of entries in the cache.
+func (c *LRUCache[K, V]) Len() int {
+	return c.list.Len()
+}
+
+// Cap returns the capacity of the cache.
+func (c *LRUCache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the hit, miss and eviction statistics of the cache.
+func (c *LRUCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// Get looks for an entry with a given key and, if it exists, marks it as the
+// most recently used entry and returns its value.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	el, exists := c.index[key]
+	if !exists {
+		c.stats.Misses++
+
+		var zero V
+		return zero, false
+	}
+
+	c.stats.Hits++
+	c.list.MoveToFront(el)
+
+	return el.Value, true
+}
+
+// Peek looks for an entry with a given key and, if it exists, returns its
+// value without marking it as used or counting the lookup in the statistics.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	el, exists := c.index[key]
+	if !exists {
+		var zero V
+		return zero, false
+	}
+
+	return el.Value, true
+}
+
+// Put adds an entry with a given key and value to the cache, or updates the
+// value if the key already exists, and marks it as the most recently used
+// entry. If the cache is full, the least recently used entry is evicted.
+func (c *LRUCache[K, V]) Put(key K, val V) {
+	if el, exists := c.index[key]; exists {
+		el.Value = val
+		c.list.MoveToFront(el)
+		return
+	}
+
+	if c.list.Len() >= c.capacity {
+		c.evict()
+	}
+
+	c.index[key] = c.list.PushFront(key, val)
+}
+
+// Remove looks for an entry with a given key and, if it exists, removes it
+// from the cache. The eviction callback is not called for removed entries.
+func (c *LRUCache[K, V]) Remove(key K) error {
+	el, exists := c.index[key]
+	if !exists {
+		return ErrNonExistingKey
+	}
+
+	delete(c.index, key)
+	if err := c.list.Delete(el); err != nil {
+		return ErrInternal
+	}
+
+	return nil
+}
+
+// Keys returns the keys of the cache from the most to the least recently used.
+func (c *LRUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.list.Len())
+	for el := c.list.Front(); el != nil; el = el.Next() {
+		keys = append(keys, el.Key)
+	}
+
+	return keys
+}
+
+// evict removes the least recently used entry from the cache.
+func (c *LRUCache[K, V]) evict() {
+	el := c.list.Back()
+	c.list.Delete(el)
+	delete(c.index, el.Key)
+	c.stats.Evictions++
+
+	if c.onEvict != nil {
+		c.onEvict(el.Key, el.Value)
+	}
+}
@@ -0,0 +1,209 @@
+package hashtable_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/hashtable"
+)
+
+// evicted records the keys and values passed to an eviction callback.
+type evicted struct {
+	keys []string
+	vals []int
+}
+
+func (e *evicted) onEvict(key string, val int) {
+	e.keys = append(e.keys, key)
+	e.vals = append(e.vals, val)
+}
+
+func TestNewLRUCache(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		capacity int
+		err      error
+	}{
+		"should return error for a negative capacity": {
+			capacity: -1,
+			err:      hashtable.ErrInvalidCapacity,
+		},
+		"should return error for a capacity of 0": {
+			capacity: 0,
+			err:      hashtable.ErrInvalidCapacity,
+		},
+		"should create a cache of capacity 1": {
+			capacity: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := hashtable.NewLRUCache[string, int](test.capacity, nil)
+			assert.Equal(test.err, err)
+			if err != nil {
+				assert.Nil(c)
+				return
+			}
+
+			assert.Equal(test.capacity, c.Cap())
+			assert.Equal(0, c.Len())
+		})
+	}
+}
+
+func TestLRUCacheEviction(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		ops     func(c *hashtable.LRUCache[string, int])
+		keys    []string
+		evicted []string
+	}{
+		"should evict nothing below capacity": {
+			ops: func(c *hashtable.LRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+			},
+			keys: []string{"b", "a"},
+		},
+		"should evict in order of insertion": {
+			ops: func(c *hashtable.LRUCache[string, int]) {
+				for i, k := range []string{"a", "b", "c", "d", "e"} {
+					c.Put(k, i)
+				}
+			},
+			keys:    []string{"e", "d", "c"},
+			evicted: []string{"a", "b"},
+		},
+		"should not evict an entry that was looked up": {
+			ops: func(c *hashtable.LRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Get("a")
+				c.Put("d", 4)
+			},
+			keys:    []string{"d", "a", "c"},
+			evicted: []string{"b"},
+		},
+		"should not evict an entry that was updated": {
+			ops: func(c *hashtable.LRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Put("a", 10)
+				c.Put("d", 4)
+			},
+			keys:    []string{"d", "a", "c"},
+			evicted: []string{"b"},
+		},
+		"should evict an entry that was peeked": {
+			ops: func(c *hashtable.LRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Peek("a")
+				c.Put("d", 4)
+			},
+			keys:    []string{"d", "c", "b"},
+			evicted: []string{"a"},
+		},
+		"should not evict after a removal": {
+			ops: func(c *hashtable.LRUCache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Remove("b")
+				c.Put("d", 4)
+			},
+			keys: []string{"d", "c", "a"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var ev evicted
+			c, _ := hashtable.NewLRUCache(3, ev.onEvict)
+			test.ops(c)
+
+			assert.Equal(test.keys, c.Keys())
+			assert.Equal(len(test.keys), c.Len())
+			assert.Equal(test.evicted, ev.keys)
+			assert.Equal(len(test.evicted), c.Stats().Evictions)
+		})
+	}
+}
+
+func TestLRUCacheGet(t *testing.T) {
+	assert := assert.New(t)
+	var ev evicted
+	c, _ := hashtable.NewLRUCache(2, ev.onEvict)
+
+	_, ok := c.Get("a")
+	assert.False(ok)
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 3)
+
+	v, ok := c.Get("a")
+	assert.True(ok)
+	assert.Equal(3, v)
+
+	v, ok = c.Peek("b")
+	assert.True(ok)
+	assert.Equal(2, v)
+
+	// the evicted value is passed to the callback
+	c.Put("c", 4)
+	assert.Equal([]string{"b"}, ev.keys)
+	assert.Equal([]int{2}, ev.vals)
+
+	_, ok = c.Peek("b")
+	assert.False(ok)
+}
+
+func TestLRUCacheStats(t *testing.T) {
+	assert := assert.New(t)
+	c, _ := hashtable.NewLRUCache[string, int](2, nil)
+	assert.Equal(hashtable.CacheStats{}, c.Stats())
+	assert.Equal(0.0, c.Stats().HitRate())
+
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("a")
+	c.Get("x")
+	c.Peek("b")
+	c.Peek("y")
+	c.Put("c", 3)
+	c.Get("b")
+
+	assert.Equal(hashtable.CacheStats{Hits: 2, Misses: 2, Evictions: 1}, c.Stats())
+	assert.Equal(0.5, c.Stats().HitRate())
+}
+
+func TestLRUCacheRemove(t *testing.T) {
+	assert := assert.New(t)
+	var ev evicted
+	c, _ := hashtable.NewLRUCache(2, ev.onEvict)
+	c.Put("a", 1)
+	c.Put("b", 2)
+
+	assert.NoError(c.Remove("a"))
+	assert.Equal(hashtable.ErrNonExistingKey, c.Remove("a"))
+	assert.Equal(hashtable.ErrNonExistingKey, c.Remove("x"))
+	assert.Equal([]string{"b"}, c.Keys())
+	assert.Equal(1, c.Len())
+
+	// removed entries are not passed to the callback
+	assert.Nil(ev.keys)
+	assert.Equal(0, c.Stats().Evictions)
+
+	// a removed key can be added again
+	c.Put("a", 3)
+	v, ok := c.Get("a")
+	assert.True(ok)
+	assert.Equal(3, v)
+}
@@ -0,0 +1,288 @@
+package numerics
+
+import (
+	"math"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// System defines a system of first order ordinary differential equations
+// dy/dt = f(t, y), where the state y is a vector. The returned vector must
+// have the same dimension as y.
+type System func(t float64, y algebraic.Vector) algebraic.Vector
+
+// Solution defines the result of integrating a system, with the times at which
+// the state was computed, and the state at each of those times.
+type Solution struct {
+	T []float64
+	Y []algebraic.Vector
+}
+
+// Last returns the final time and state of the solution.
+func (s *Solution) Last() (float64, algebraic.Vector) {
+	n := len(s.T) - 1
+	return s.T[n], s.Y[n]
+}
+
+// RK4Step advances the state y of a system f from time t by a single step of
+// size h, using the classical fourth order Runge-Kutta method, and returns the
+// new state. The given state is not altered.
+func RK4Step(f System, t float64, y algebraic.Vector, h float64) (algebraic.Vector, error) {
+	k1, err := eval(f, t, y)
+	if err != nil {
+		return nil, err
+	}
+
+	k2, err := eval(f, t+h/2, axpy(y, h/2, k1))
+	if err != nil {
+		return nil, err
+	}
+
+	k3, err := eval(f, t+h/2, axpy(y, h/2, k2))
+	if err != nil {
+		return nil, err
+	}
+
+	k4, err := eval(f, t+h, axpy(y, h, k3))
+	if err != nil {
+		return nil, err
+	}
+
+	yn := algebraic.NewVector(y.Dimension(), y...)
+	for i := range yn {
+		yn[i] += h / 6 * (k1[i] + 2*k2[i] + 2*k3[i] + k4[i])
+	}
+
+	return yn, nil
+}
+
+// RK4 integrates the system f from time t0 to t1 with initial state y0, using
+// the given number of equally sized steps of the classical fourth order
+// Runge-Kutta method. The global error is O(h⁴), where h = (t1-t0)/steps.
+func RK4(f System, y0 algebraic.Vector, t0, t1 float64, steps int) (*Solution, error) {
+	if steps <= 0 {
+		return nil, ErrInvalidSteps
+	}
+
+	h := (t1 - t0) / float64(steps)
+	y := algebraic.NewVector(y0.Dimension(), y0...)
+	sol := &Solution{
+		T: []float64{t0},
+		Y: []algebraic.Vector{y},
+	}
+
+	for i := range steps {
+		t := t0 + float64(i)*h
+
+		var err error
+		y, err = RK4Step(f, t, y, h)
+		if err != nil {
+			return nil, err
+		}
+
+		sol.T = append(sol.T, t+h)
+		sol.Y = append(sol.Y, y)
+	}
+
+	return sol, nil
+}
+
+// RK45Options defines the tolerances and step limits of the adaptive RK45
+// integrator. A step is accepted when its estimated error is within
+// AbsTol + RelTol·|y| for every coordinate. If InitialStep is zero, a hundredth
+// of the interval is used. If MaxStep is zero, the step size is only bounded by
+// the interval. Any other field left at zero is taken from DefaultRK45Options,
+// so only the fields of interest need to be set.
+type RK45Options struct {
+	RelTol      float64
+	AbsTol      float64
+	InitialStep float64
+	MinStep     float64
+	MaxStep     float64
+	MaxSteps    int
+}
+
+// DefaultRK45Options are the options used by RK45 when none are given.
+var DefaultRK45Options = RK45Options{
+	RelTol:   1e-6,
+	AbsTol:   1e-9,
+	MinStep:  1e-12,
+	MaxSteps: 100000,
+}
+
+// Dormand-Prince coefficients. The nodes are given by c, the Runge-Kutta matrix
+// by a, and the weights of the fifth and fourth order solutions by b5 and b4.
+var (
+	dpC = [7]float64{0, 1.0 / 5, 3.0 / 10, 4.0 / 5, 8.0 / 9, 1, 1}
+	dpA = [7][6]float64{
+		{},
+		{1.0 / 5},
+		{3.0 / 40, 9.0 / 40},
+		{44.0 / 45, -56.0 / 15, 32.0 / 9},
+		{19372.0 / 6561, -25360.0 / 2187, 64448.0 / 6561, -212.0 / 729},
+		{9017.0 / 3168, -355.0 / 33, 46732.0 / 5247, 49.0 / 176, -5103.0 / 18656},
+		{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84},
+	}
+	dpB5 = [7]float64{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84, 0}
+	dpB4 = [7]float64{5179.0 / 57600, 0, 7571.0 / 16695, 393.0 / 640, -92097.0 / 339200, 187.0 / 2100, 1.0 / 40}
+)
+
+// RK45 integrates the system f from time t0 to t1 with initial state y0, using
+// the adaptive Dormand-Prince method. Each step computes a fifth and a fourth
+// order solution from the same seven stages, and the difference between them
+// serves as an error estimate used to accept or reject the step, and to choose
+// the size of the next. The returned solution holds the state at each accepted
+// step. If opts is nil, DefaultRK45Options is used. If any of the options is
+// negative, an error is returned.
+func RK45(f System, y0 algebraic.Vector, t0, t1 float64, opts *RK45Options) (*Solution, error) {
+	if t0 == t1 {
+		return nil, ErrInvalidInterval
+	}
+
+	if opts == nil {
+		opts = &DefaultRK45Options
+	}
+
+	if opts.RelTol < 0 || opts.AbsTol < 0 || opts.InitialStep < 0 ||
+		opts.MinStep < 0 || opts.MaxStep < 0 || opts.MaxSteps < 0 {
+		return nil, ErrInvalidOptions
+	}
+
+	opts = opts.withDefaults()
+
+	var (
+		dir  = math.Copysign(1, t1-t0)
+		span = math.Abs(t1 - t0)
+		h    = opts.InitialStep
+		t    = t0
+		y    = algebraic.NewVector(y0.Dimension(), y0...)
+		k    [7]algebraic.Vector
+	)
+
+	if h <= 0 {
+		h = span / 100
+	}
+
+	maxStep := opts.MaxStep
+	if maxStep <= 0 {
+		maxStep = span
+	}
+
+	sol := &Solution{
+		T: []float64{t0},
+		Y: []algebraic.Vector{y},
+	}
+
+	for steps := 0; dir*(t1-t) > 0; steps++ {
+		if steps >= opts.MaxSteps {
+			return sol, ErrMaxSteps
+		}
+
+		h = math.Min(h, maxStep)
+		// don't step past the end of the interval
+		if h > dir*(t1-t) {
+			h = dir * (t1 - t)
+		}
+
+		for s := range k {
+			ys := algebraic.NewVector(y.Dimension(), y...)
+			for j := range s {
+				for i := range ys {
+					ys[i] += dir * h * dpA[s][j] * k[j][i]
+				}
+			}
+
+			var err error
+			k[s], err = eval(f, t+dir*h*dpC[s], ys)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		yn := algebraic.NewVector(y.Dimension(), y...)
+		var errNorm float64
+		for i := range yn {
+			var d5, d4 float64
+			for s := range k {
+				d5 += dpB5[s] * k[s][i]
+				d4 += dpB4[s] * k[s][i]
+			}
+
+			yn[i] += dir * h * d5
+			sc := opts.AbsTol + opts.RelTol*math.Max(math.Abs(y[i]), math.Abs(yn[i]))
+			e := h * (d5 - d4) / sc
+			errNorm += e * e
+		}
+
+		if len(yn) > 0 {
+			errNorm = math.Sqrt(errNorm / float64(len(yn)))
+		}
+
+		if errNorm <= 1 {
+			t += dir * h
+			y = yn
+			sol.T = append(sol.T, t)
+			sol.Y = append(sol.Y, y)
+		}
+
+		// scale the step size by the optimal factor, with a safety margin, but
+		// never change it by more than a factor of 5 at a time
+		fac := 5.0
+		if errNorm > 0 {
+			fac = math.Min(5, math.Max(0.2, 0.9*math.Pow(errNorm, -0.2)))
+		}
+		h *= fac
+
+		if h < opts.MinStep && dir*(t1-t) > opts.MinStep {
+			return sol, ErrStepTooSmall
+		}
+	}
+
+	return sol, nil
+}
+
+// withDefaults returns a copy of the options, where the tolerances and step
+// limits left at zero are taken from DefaultRK45Options. With zero tolerances
+// the error of every step would be infinite, and without a minimum step size
+// or a maximum number of steps the integration would never end.
+func (o *RK45Options) withDefaults() *RK45Options {
+	c := *o
+	if c.RelTol == 0 {
+		c.RelTol = DefaultRK45Options.RelTol
+	}
+
+	if c.AbsTol == 0 {
+		c.AbsTol = DefaultRK45Options.AbsTol
+	}
+
+	if c.MinStep == 0 {
+		c.MinStep = DefaultRK45Options.MinStep
+	}
+
+	if c.MaxSteps == 0 {
+		c.MaxSteps = DefaultRK45Options.MaxSteps
+	}
+
+	return &c
+}
+
+// eval evaluates the system f at time t and state y, and ensures the returned
+// vector has the same dimension as y.
+func eval(f System, t float64, y algebraic.Vector) (algebraic.Vector, error) {
+	dy := f(t, y)
+	if dy.Dimension() != y.Dimension() {
+		return nil, ErrDimMismatch
+	}
+
+	return dy, nil
+}
+
+// axpy returns a new vector y + a·x.
+func axpy(y algebraic.Vector, a float64, x algebraic.Vector) algebraic.Vector {
+	z := algebraic.NewVector(y.Dimension(), y...)
+	for i := range z {
+		z[i] += a * x[i]
+	}
+
+	return z
+}
@@ -0,0 +1,151 @@
+package numerics_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/numerics"
+)
+
+// decay defines the system dy/dt = -y, with solution y(t) = y(0)·e^(-t).
+func decay(t float64, y algebraic.Vector) algebraic.Vector {
+	dy := algebraic.NewVector(y.Dimension(), y...)
+	dy.Scale(-1)
+	return dy
+}
+
+// oscillator defines the harmonic oscillator d²x/dt² = -x as a first order
+// system, with solution x(t) = cos(t) given x(0) = 1 and x'(0) = 0.
+func oscillator(t float64, y algebraic.Vector) algebraic.Vector {
+	return algebraic.NewVector(2, y[1], -y[0])
+}
+
+func TestRK4(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		f       numerics.System
+		y0      algebraic.Vector
+		t1      float64
+		steps   int
+		want    algebraic.Vector
+		wantErr error
+	}{
+		"should integrate exponential decay": {
+			f:     decay,
+			y0:    algebraic.NewVector(1, 1),
+			t1:    1,
+			steps: 100,
+			want:  algebraic.NewVector(1, math.Exp(-1)),
+		},
+		"should integrate the harmonic oscillator over a full period": {
+			f:     oscillator,
+			y0:    algebraic.NewVector(2, 1, 0),
+			t1:    2 * math.Pi,
+			steps: 1000,
+			want:  algebraic.NewVector(2, 1, 0),
+		},
+		"should return an error given no steps": {
+			f:       decay,
+			y0:      algebraic.NewVector(1, 1),
+			t1:      1,
+			wantErr: numerics.ErrInvalidSteps,
+		},
+		"should return an error given a system of wrong dimension": {
+			f:       oscillator,
+			y0:      algebraic.NewVector(3, 1, 0, 0),
+			t1:      1,
+			steps:   10,
+			wantErr: numerics.ErrDimMismatch,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			sol, err := numerics.RK4(test.f, test.y0, 0, test.t1, test.steps)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.Len(sol.T, test.steps+1)
+				tn, yn := sol.Last()
+				assert.InDelta(test.t1, tn, 1e-9)
+				assert.InDeltaSlice(test.want, yn, 1e-8)
+			}
+		})
+	}
+}
+
+func TestRK45(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		f       numerics.System
+		y0      algebraic.Vector
+		t0, t1  float64
+		opts    *numerics.RK45Options
+		want    algebraic.Vector
+		wantErr error
+	}{
+		"should integrate exponential decay": {
+			f:    decay,
+			y0:   algebraic.NewVector(1, 1),
+			t1:   5,
+			want: algebraic.NewVector(1, math.Exp(-5)),
+		},
+		"should fill partial options from the defaults": {
+			f:    decay,
+			y0:   algebraic.NewVector(1, 1),
+			t1:   1,
+			opts: &numerics.RK45Options{MaxStep: 0.1},
+			want: algebraic.NewVector(1, math.Exp(-1)),
+		},
+		"should return an error given negative options": {
+			f:       decay,
+			y0:      algebraic.NewVector(1, 1),
+			t1:      1,
+			opts:    &numerics.RK45Options{RelTol: -1},
+			wantErr: numerics.ErrInvalidOptions,
+		},
+		"should return an error when the step limit is exceeded": {
+			f:       decay,
+			y0:      algebraic.NewVector(1, 1),
+			t1:      1,
+			opts:    &numerics.RK45Options{MaxStep: 0.1, MaxSteps: 5},
+			wantErr: numerics.ErrMaxSteps,
+		},
+		"should integrate the harmonic oscillator over a full period": {
+			f:    oscillator,
+			y0:   algebraic.NewVector(2, 1, 0),
+			t1:   2 * math.Pi,
+			want: algebraic.NewVector(2, 1, 0),
+		},
+		"should integrate backwards in time": {
+			f:    decay,
+			y0:   algebraic.NewVector(1, 1),
+			t0:   1,
+			t1:   0,
+			want: algebraic.NewVector(1, math.E),
+		},
+		"should return an error given an empty interval": {
+			f:       decay,
+			y0:      algebraic.NewVector(1, 1),
+			wantErr: numerics.ErrInvalidInterval,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			sol, err := numerics.RK45(test.f, test.y0, test.t0, test.t1, test.opts)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				tn, yn := sol.Last()
+				assert.Equal(test.t1, tn)
+				assert.InDeltaSlice(test.want, yn, 1e-5)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package numerics
+
+import (
+	"errors"
+	"math"
+)
+
+// Various errors a numerical function can return.
+var (
+	ErrInvalidSteps    = errors.New("number of steps must be positive")
+	ErrOddIntervals    = errors.New("number of intervals must be even")
+	ErrInvalidPoints   = errors.New("number of points must be positive")
+	ErrInvalidInterval = errors.New("interval end must differ from its start")
+	ErrStepTooSmall    = errors.New("step size became too small")
+	ErrMaxSteps        = errors.New("maximum number of steps exceeded")
+	ErrDimMismatch     = errors.New("system returned a vector of wrong dimension")
+	ErrInvalidOptions  = errors.New("tolerances and step limits must not be negative")
+)
+
+// Func defines a real function of a single variable.
+type Func func(float64) float64
+
+// Simpson approximates the integral of f over the interval [a, b] using the
+// composite Simpson's rule with n subintervals. The number of subintervals must
+// be even, since the rule fits a parabola through each pair of subintervals.
+// The error of the approximation is O(h⁴), where h = (b-a)/n.
+func Simpson(f Func, a, b float64, n int) (float64, error) {
+	if n <= 0 {
+		return 0, ErrInvalidSteps
+	}
+
+	if n%2 != 0 {
+		return 0, ErrOddIntervals
+	}
+
+	h := (b - a) / float64(n)
+	sum := f(a) + f(b)
+
+	for i := 1; i < n; i++ {
+		x := a + float64(i)*h
+		if i%2 == 0 {
+			sum += 2 * f(x)
+		} else {
+			sum += 4 * f(x)
+		}
+	}
+
+	return sum * h / 3, nil
+}
+
+// GaussLegendre approximates the integral of f over the interval [a, b] using
+// n-point Gauss-Legendre quadrature. The rule evaluates f at the roots of the
+// n-th Legendre polynomial, mapped from [-1, 1] onto [a, b], and is exact for
+// polynomials of degree 2n-1 or less.
+func GaussLegendre(f Func, a, b float64, n int) (float64, error) {
+	if n <= 0 {
+		return 0, ErrInvalidPoints
+	}
+
+	xs, ws := legendreNodes(n)
+
+	// map [-1, 1] onto [a, b]
+	mid := (a + b) / 2
+	half := (b - a) / 2
+
+	var sum float64
+	for i := range xs {
+		sum += ws[i] * f(mid+half*xs[i])
+	}
+
+	return sum * half, nil
+}
+
+// legendreNodes returns the roots of the n-th Legendre polynomial along with
+// their quadrature weights. Each root is found by Newton's method, starting
+// from an asymptotic approximation, while the polynomial and its derivative are
+// evaluated using the three-term recurrence relation.
+func legendreNodes(n int) ([]float64, []float64) {
+	xs := make([]float64, n)
+	ws := make([]float64, n)
+
+	for i := range n {
+		x := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+
+		var dp float64
+		for range 100 {
+			p0, p1 := 1.0, x
+			for k := 2; k <= n; k++ {
+				p0, p1 = p1, (float64(2*k-1)*x*p1-float64(k-1)*p0)/float64(k)
+			}
+
+			dp = float64(n) * (x*p1 - p0) / (x*x - 1)
+			dx := p1 / dp
+			x -= dx
+
+			if math.Abs(dx) < 1e-15 {
+				break
+			}
+		}
+
+		xs[i] = x
+		ws[i] = 2 / ((1 - x*x) * dp * dp)
+	}
+
+	return xs, ws
+}
@@ -0,0 +1,108 @@
+package numerics_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/numerics"
+)
+
+func TestSimpson(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		f       numerics.Func
+		a, b    float64
+		n       int
+		want    float64
+		wantErr error
+	}{
+		"should integrate a cubic exactly": {
+			f:    func(x float64) float64 { return x * x * x },
+			a:    0,
+			b:    2,
+			n:    2,
+			want: 4,
+		},
+		"should integrate sine over half a period": {
+			f:    math.Sin,
+			a:    0,
+			b:    math.Pi,
+			n:    100,
+			want: 2,
+		},
+		"should return an error given an odd number of intervals": {
+			f:       math.Sin,
+			n:       3,
+			wantErr: numerics.ErrOddIntervals,
+		},
+		"should return an error given no intervals": {
+			f:       math.Sin,
+			n:       0,
+			wantErr: numerics.ErrInvalidSteps,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := numerics.Simpson(test.f, test.a, test.b, test.n)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.InDelta(test.want, got, 1e-6)
+			}
+		})
+	}
+}
+
+func TestGaussLegendre(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		f       numerics.Func
+		a, b    float64
+		n       int
+		want    float64
+		wantErr error
+	}{
+		"should integrate a linear function exactly with a single point": {
+			f:    func(x float64) float64 { return 2*x + 1 },
+			a:    0,
+			b:    3,
+			n:    1,
+			want: 12,
+		},
+		"should integrate a degree 5 polynomial exactly with three points": {
+			f:    func(x float64) float64 { return math.Pow(x, 5) - x*x },
+			a:    -1,
+			b:    2,
+			n:    3,
+			want: 63.0/6 - 3,
+		},
+		"should integrate the exponential function": {
+			f:    math.Exp,
+			a:    0,
+			b:    1,
+			n:    8,
+			want: math.E - 1,
+		},
+		"should return an error given no points": {
+			f:       math.Exp,
+			n:       0,
+			wantErr: numerics.ErrInvalidPoints,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := numerics.GaussLegendre(test.f, test.a, test.b, test.n)
+			if test.wantErr != nil {
+				assert.ErrorIs(err, test.wantErr)
+			} else {
+				assert.NoError(err)
+				assert.InDelta(test.want, got, 1e-12)
+			}
+		})
+	}
+}
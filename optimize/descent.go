@@ -0,0 +1,49 @@
+package optimize
+
+import (
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// GradientDescent minimizes the problem p from the initial point x0 by
+// steepest descent, i.e. by repeatedly stepping along the negative gradient.
+// The step size is found by a backtracking line search, starting from twice the
+// previously accepted step. Convergence is linear, and can be slow on poorly
+// conditioned problems. If s is nil, DefaultSettings is used.
+func GradientDescent(p Problem, x0 algebraic.Vector, s *Settings) (*Result, error) {
+	e, set, err := newEvaluator(p, x0, s)
+	if err != nil {
+		return nil, err
+	}
+
+	x := clone(x0)
+	fx := e.f(x)
+	g, err := e.grad(x)
+	if err != nil {
+		return nil, err
+	}
+
+	alpha := 1.0
+	for iter := range set.MaxIter {
+		if normInf(g) < set.GradTol {
+			return e.result(x, fx, g, GradientConverged, iter), nil
+		}
+
+		d := clone(g)
+		d.Scale(-1)
+
+		x, fx, alpha, err = e.backtrack(x, d, fx, g, 2*alpha)
+		if err != nil {
+			return nil, err
+		}
+
+		if g, err = e.grad(x); err != nil {
+			return nil, err
+		}
+	}
+
+	if normInf(g) < set.GradTol {
+		return e.result(x, fx, g, GradientConverged, set.MaxIter), nil
+	}
+
+	return e.result(x, fx, g, IterationLimit, set.MaxIter), nil
+}
@@ -0,0 +1,146 @@
+package optimize
+
+import (
+	"math"
+	"sort"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// NelderMead minimizes the problem p from the initial point x0 by the
+// derivative-free Nelder-Mead simplex method. A simplex of n+1 points is built
+// around x0, after which the worst point is repeatedly replaced by reflecting,
+// expanding or contracting it through the centroid of the others. If none of
+// these improve on it, the whole simplex is shrunk towards the best point. The
+// method converges when the spread of the function values across the simplex
+// is below FuncTol. Only Func of the problem is used. If s is nil,
+// DefaultSettings is used.
+func NelderMead(p Problem, x0 algebraic.Vector, s *Settings) (*Result, error) {
+	e, set, err := newEvaluator(p, x0, s)
+	if err != nil {
+		return nil, err
+	}
+
+	const (
+		reflect  = 1.0
+		expand   = 2.0
+		contract = 0.5
+		shrink   = 0.5
+	)
+
+	n := len(x0)
+	xs := make([]algebraic.Vector, n+1)
+	fs := make([]float64, n+1)
+
+	xs[0] = clone(x0)
+	for i := range n {
+		x := clone(x0)
+		if x[i] != 0 {
+			x[i] *= 1 + set.Step
+		} else {
+			x[i] = set.Step
+		}
+
+		xs[i+1] = x
+	}
+
+	for i := range xs {
+		fs[i] = e.f(xs[i])
+	}
+
+	order := func() {
+		sort.Sort(simplex{xs, fs})
+	}
+
+	for iter := range set.MaxIter {
+		order()
+
+		if spread(fs) < set.FuncTol {
+			return e.result(xs[0], fs[0], nil, FunctionConverged, iter), nil
+		}
+
+		// centroid of all points but the worst
+		c := algebraic.NewZeroVector(uint(n))
+		for _, x := range xs[:n] {
+			c.Add(x)
+		}
+		c.Scale(1 / float64(n))
+
+		// move from the centroid away from, or towards, the worst point
+		along := func(a float64) algebraic.Vector {
+			d := clone(c)
+			d.Sub(xs[n])
+			return axpy(c, a, d)
+		}
+
+		xr := along(reflect)
+		fr := e.f(xr)
+
+		switch {
+		case fr < fs[0]:
+			xe := along(expand)
+			if fe := e.f(xe); fe < fr {
+				xs[n], fs[n] = xe, fe
+			} else {
+				xs[n], fs[n] = xr, fr
+			}
+		case fr < fs[n-1]:
+			xs[n], fs[n] = xr, fr
+		default:
+			var xc algebraic.Vector
+			if fr < fs[n] {
+				xc = along(contract)
+			} else {
+				xc = along(-contract)
+			}
+
+			if fc := e.f(xc); fc < math.Min(fr, fs[n]) {
+				xs[n], fs[n] = xc, fc
+			} else {
+				for i := 1; i <= n; i++ {
+					d := clone(xs[i])
+					d.Sub(xs[0])
+					xs[i] = axpy(xs[0], shrink, d)
+					fs[i] = e.f(xs[i])
+				}
+			}
+		}
+	}
+
+	order()
+	if spread(fs) < set.FuncTol {
+		return e.result(xs[0], fs[0], nil, FunctionConverged, set.MaxIter), nil
+	}
+
+	return e.result(xs[0], fs[0], nil, IterationLimit, set.MaxIter), nil
+}
+
+// simplex implements sort.Interface to order the points of a simplex by their
+// function values.
+type simplex struct {
+	xs []algebraic.Vector
+	fs []float64
+}
+
+func (s simplex) Len() int           { return len(s.fs) }
+func (s simplex) Less(i, j int) bool { return s.fs[i] < s.fs[j] }
+func (s simplex) Swap(i, j int) {
+	s.xs[i], s.xs[j] = s.xs[j], s.xs[i]
+	s.fs[i], s.fs[j] = s.fs[j], s.fs[i]
+}
+
+// spread returns the standard deviation of the function values.
+func spread(fs []float64) float64 {
+	var mean float64
+	for _, f := range fs {
+		mean += f
+	}
+	mean /= float64(len(fs))
+
+	var v float64
+	for _, f := range fs {
+		v += (f - mean) * (f - mean)
+	}
+
+	return math.Sqrt(v / float64(len(fs)))
+}
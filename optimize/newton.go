@@ -0,0 +1,62 @@
+package optimize
+
+import (
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Newton minimizes the problem p from the initial point x0 by Newton's method.
+// At each iteration the Newton direction d is found by solving H·d = -g, where
+// H is the Hessian and g the gradient at the current point. If H is singular or
+// d is not a descent direction, e.g. because H is not positive definite, the
+// negative gradient is used instead. A backtracking line search starting at the
+// full Newton step ensures the function decreases. Near a minimum convergence
+// is quadratic. If s is nil, DefaultSettings is used.
+func Newton(p Problem, x0 algebraic.Vector, s *Settings) (*Result, error) {
+	e, set, err := newEvaluator(p, x0, s)
+	if err != nil {
+		return nil, err
+	}
+
+	x := clone(x0)
+	fx := e.f(x)
+	g, err := e.grad(x)
+	if err != nil {
+		return nil, err
+	}
+
+	for iter := range set.MaxIter {
+		if normInf(g) < set.GradTol {
+			return e.result(x, fx, g, GradientConverged, iter), nil
+		}
+
+		h, err := e.hess(x)
+		if err != nil {
+			return nil, err
+		}
+
+		ng := clone(g)
+		ng.Scale(-1)
+
+		d, err := h.Solve(ng)
+		if err != nil {
+			d = ng
+		} else if slope, _ := g.Dot(d); slope >= 0 {
+			d = ng
+		}
+
+		x, fx, _, err = e.backtrack(x, d, fx, g, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if g, err = e.grad(x); err != nil {
+			return nil, err
+		}
+	}
+
+	if normInf(g) < set.GradTol {
+		return e.result(x, fx, g, GradientConverged, set.MaxIter), nil
+	}
+
+	return e.result(x, fx, g, IterationLimit, set.MaxIter), nil
+}
@@ -0,0 +1,327 @@
+package optimize
+
+import (
+	"errors"
+	"math"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Various errors a minimization function can return.
+var (
+	ErrNoFunc          = errors.New("objective function is missing")
+	ErrInsufficientDim = errors.New("initial point dimension is insufficient")
+	ErrLineSearch      = errors.New("line search failed to decrease the function")
+	ErrDimMismatch     = errors.New("gradient or hessian dimension does not match point")
+	ErrInvalidSettings = errors.New("tolerances, limits and step size must not be negative")
+)
+
+// Problem defines a minimization problem with an objective function of a
+// vector, along with its gradient and Hessian. Grad and Hess are optional - if
+// left nil, they are approximated by finite differences.
+type Problem struct {
+	Func func(x algebraic.Vector) float64
+	Grad func(x algebraic.Vector) algebraic.Vector
+	Hess func(x algebraic.Vector) algebraic.Matrix
+}
+
+// Settings defines the convergence criteria and parameters of the minimization
+// methods. A method converges when the largest absolute coordinate of the
+// gradient is below GradTol, or, for Nelder-Mead, when the spread of the
+// function values across the simplex is below FuncTol. Memory is the number of
+// correction pairs kept by L-BFGS, and Step is the initial size of the simplex
+// for Nelder-Mead. Any field left at zero is taken from DefaultSettings, so
+// only the fields of interest need to be set, while negative fields are
+// rejected with an error.
+type Settings struct {
+	GradTol float64
+	FuncTol float64
+	MaxIter int
+	Memory  int
+	Step    float64
+}
+
+// DefaultSettings are the settings used when none are given.
+var DefaultSettings = Settings{
+	GradTol: 1e-6,
+	FuncTol: 1e-12,
+	MaxIter: 1000,
+	Memory:  10,
+	Step:    0.1,
+}
+
+// Status represents the reason a minimization method terminated.
+type Status int
+
+const (
+	NotConverged Status = iota
+	GradientConverged
+	FunctionConverged
+	IterationLimit
+)
+
+// String returns a description of the status.
+func (s Status) String() string {
+	switch s {
+	case GradientConverged:
+		return "gradient converged"
+	case FunctionConverged:
+		return "function converged"
+	case IterationLimit:
+		return "iteration limit reached"
+	default:
+		return "not converged"
+	}
+}
+
+// Result defines the convergence report of a minimization, with the point of
+// the found minimum, the function value and gradient at that point, along with
+// the number of iterations and function and gradient evaluations used.
+type Result struct {
+	X          algebraic.Vector
+	F          float64
+	Grad       algebraic.Vector
+	Status     Status
+	Iterations int
+	FuncEvals  int
+	GradEvals  int
+}
+
+// Converged checks if the minimization terminated by meeting a convergence
+// criterion.
+func (r *Result) Converged() bool {
+	return r.Status == GradientConverged || r.Status == FunctionConverged
+}
+
+// evaluator wraps a problem to count function and gradient evaluations, and to
+// fall back to numerical derivatives when none are given.
+type evaluator struct {
+	p         Problem
+	funcEvals int
+	gradEvals int
+}
+
+// f evaluates the objective function at x.
+func (e *evaluator) f(x algebraic.Vector) float64 {
+	e.funcEvals++
+	return e.p.Func(x)
+}
+
+// grad evaluates the gradient at x.
+func (e *evaluator) grad(x algebraic.Vector) (algebraic.Vector, error) {
+	e.gradEvals++
+	if e.p.Grad == nil {
+		g := NumericalGradient(e.p.Func, x)
+		e.funcEvals += 2 * len(x)
+		return g, nil
+	}
+
+	g := e.p.Grad(x)
+	if g.Dimension() != x.Dimension() {
+		return nil, ErrDimMismatch
+	}
+
+	return g, nil
+}
+
+// hess evaluates the Hessian at x. If it is approximated from the gradient,
+// the first error returned by the gradient is returned.
+func (e *evaluator) hess(x algebraic.Vector) (algebraic.Matrix, error) {
+	if e.p.Hess == nil {
+		var gerr error
+		h := NumericalHessian(func(y algebraic.Vector) algebraic.Vector {
+			g, err := e.grad(y)
+			if err != nil {
+				if gerr == nil {
+					gerr = err
+				}
+
+				// keep the differencing going on a vector of the right size
+				return algebraic.NewZeroVector(y.Dimension())
+			}
+
+			return g
+		}, x)
+
+		if gerr != nil {
+			return nil, gerr
+		}
+
+		return h, nil
+	}
+
+	h := e.p.Hess(x)
+	if rows, cols := h.Dims(); rows != x.Dimension() || cols != x.Dimension() {
+		return nil, ErrDimMismatch
+	}
+
+	return h, nil
+}
+
+// result creates a result for a given point and status.
+func (e *evaluator) result(x algebraic.Vector, fx float64, g algebraic.Vector, s Status, iter int) *Result {
+	return &Result{
+		X:          x,
+		F:          fx,
+		Grad:       g,
+		Status:     s,
+		Iterations: iter,
+		FuncEvals:  e.funcEvals,
+		GradEvals:  e.gradEvals,
+	}
+}
+
+// newEvaluator validates the problem, the initial point and the settings, and
+// returns an evaluator along with the settings to use.
+func newEvaluator(p Problem, x0 algebraic.Vector, s *Settings) (*evaluator, Settings, error) {
+	if p.Func == nil {
+		return nil, Settings{}, ErrNoFunc
+	}
+
+	if x0.Dimension() == 0 {
+		return nil, Settings{}, ErrInsufficientDim
+	}
+
+	if s == nil {
+		s = &DefaultSettings
+	}
+
+	if s.GradTol < 0 || s.FuncTol < 0 || s.MaxIter < 0 || s.Memory < 0 || s.Step < 0 {
+		return nil, Settings{}, ErrInvalidSettings
+	}
+
+	return &evaluator{p: p}, s.withDefaults(), nil
+}
+
+// withDefaults returns a copy of the settings, where the fields left at zero
+// are taken from DefaultSettings. With a zero iteration limit no iteration
+// would be run, and with a zero step the initial simplex of Nelder-Mead would
+// be degenerate.
+func (s *Settings) withDefaults() Settings {
+	c := *s
+	if c.GradTol == 0 {
+		c.GradTol = DefaultSettings.GradTol
+	}
+
+	if c.FuncTol == 0 {
+		c.FuncTol = DefaultSettings.FuncTol
+	}
+
+	if c.MaxIter == 0 {
+		c.MaxIter = DefaultSettings.MaxIter
+	}
+
+	if c.Memory == 0 {
+		c.Memory = DefaultSettings.Memory
+	}
+
+	if c.Step == 0 {
+		c.Step = DefaultSettings.Step
+	}
+
+	return c
+}
+
+// NumericalGradient approximates the gradient of f at x by central
+// differences, using a step size relative to the magnitude of each coordinate.
+func NumericalGradient(f func(algebraic.Vector) float64, x algebraic.Vector) algebraic.Vector {
+	g := algebraic.NewZeroVector(x.Dimension())
+	y := clone(x)
+
+	for i := range x {
+		h := diffStep(x[i])
+		y[i] = x[i] + h
+		fp := f(y)
+		y[i] = x[i] - h
+		fm := f(y)
+		y[i] = x[i]
+
+		g[i] = (fp - fm) / (2 * h)
+	}
+
+	return g
+}
+
+// NumericalHessian approximates the Hessian at x by central differences of a
+// given gradient function. The result is symmetrized, since the Hessian of a
+// twice continuously differentiable function is symmetric.
+func NumericalHessian(grad func(algebraic.Vector) algebraic.Vector, x algebraic.Vector) algebraic.Matrix {
+	n := x.Dimension()
+	h := algebraic.NewMatrix(n, n)
+	y := clone(x)
+
+	for j := range x {
+		s := diffStep(x[j])
+		y[j] = x[j] + s
+		gp := grad(y)
+		y[j] = x[j] - s
+		gm := grad(y)
+		y[j] = x[j]
+
+		for i := range x {
+			h[i][j] = (gp[i] - gm[i]) / (2 * s)
+		}
+	}
+
+	for i := range x {
+		for j := range i {
+			a := (h[i][j] + h[j][i]) / 2
+			h[i][j], h[j][i] = a, a
+		}
+	}
+
+	return h
+}
+
+// diffStep returns the finite difference step for a given coordinate, i.e. the
+// cube root of the machine epsilon scaled by the coordinate's magnitude, which
+// balances truncation and rounding errors for central differences.
+func diffStep(c float64) float64 {
+	return math.Cbrt(2.2e-16) * math.Max(1, math.Abs(c))
+}
+
+// backtrack performs a backtracking line search along a descent direction d
+// from x, starting at a step size alpha and halving it until the Armijo
+// sufficient decrease condition is satisfied. It returns the new point, its
+// function value and the accepted step size.
+func (e *evaluator) backtrack(x, d algebraic.Vector, fx float64, g algebraic.Vector, alpha float64) (algebraic.Vector, float64, float64, error) {
+	const c1 = 1e-4
+
+	slope, _ := g.Dot(d)
+	for range 60 {
+		y := axpy(x, alpha, d)
+		fy := e.f(y)
+		if fy <= fx+c1*alpha*slope {
+			return y, fy, alpha, nil
+		}
+
+		alpha /= 2
+	}
+
+	return nil, 0, 0, ErrLineSearch
+}
+
+// clone returns a copy of a vector.
+func clone(v algebraic.Vector) algebraic.Vector {
+	return algebraic.NewVector(v.Dimension(), v...)
+}
+
+// axpy returns a new vector y + a·x.
+func axpy(y algebraic.Vector, a float64, x algebraic.Vector) algebraic.Vector {
+	z := clone(y)
+	for i := range z {
+		z[i] += a * x[i]
+	}
+
+	return z
+}
+
+// normInf returns the largest absolute coordinate of a vector.
+func normInf(v algebraic.Vector) float64 {
+	var n float64
+	for _, c := range v {
+		n = math.Max(n, math.Abs(c))
+	}
+
+	return n
+}
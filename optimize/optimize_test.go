@@ -0,0 +1,200 @@
+package optimize_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/optimize"
+)
+
+// rosenbrock defines the Rosenbrock function, with its minimum 0 at (1, 1).
+var rosenbrock = optimize.Problem{
+	Func: func(x algebraic.Vector) float64 {
+		a, b := 1-x[0], x[1]-x[0]*x[0]
+		return a*a + 100*b*b
+	},
+	Grad: func(x algebraic.Vector) algebraic.Vector {
+		b := x[1] - x[0]*x[0]
+		return algebraic.NewVector(2, -2*(1-x[0])-400*x[0]*b, 200*b)
+	},
+	Hess: func(x algebraic.Vector) algebraic.Matrix {
+		return algebraic.NewMatrix(2, 2,
+			2-400*x[1]+1200*x[0]*x[0], -400*x[0],
+			-400*x[0], 200,
+		)
+	},
+}
+
+// quadratic defines a convex quadratic function with its minimum 0 at
+// (1, -2, 3), and no derivatives given.
+var quadratic = optimize.Problem{
+	Func: func(x algebraic.Vector) float64 {
+		a, b, c := x[0]-1, x[1]+2, x[2]-3
+		return a*a + 2*b*b + 3*c*c + a*b
+	},
+}
+
+type method func(optimize.Problem, algebraic.Vector, *optimize.Settings) (*optimize.Result, error)
+
+func TestMinimize(t *testing.T) {
+	assert := assert.New(t)
+	settings := optimize.DefaultSettings
+	settings.MaxIter = 20000
+
+	methods := map[string]method{
+		"gradient descent": optimize.GradientDescent,
+		"newton":           optimize.Newton,
+		"bfgs":             optimize.BFGS,
+		"l-bfgs":           optimize.LBFGS,
+		"nelder-mead":      optimize.NelderMead,
+	}
+
+	tests := map[string]struct {
+		p    optimize.Problem
+		x0   algebraic.Vector
+		want algebraic.Vector
+	}{
+		"the rosenbrock function": {
+			p:    rosenbrock,
+			x0:   algebraic.NewVector(2, -1.2, 1),
+			want: algebraic.NewVector(2, 1, 1),
+		},
+		"a quadratic function with numerical derivatives": {
+			p:    quadratic,
+			x0:   algebraic.NewVector(3, 0, 0, 0),
+			want: algebraic.NewVector(3, 1, -2, 3),
+		},
+	}
+
+	for mname, m := range methods {
+		for name, test := range tests {
+			t.Run("should minimize "+name+" using "+mname, func(t *testing.T) {
+				res, err := m(test.p, test.x0, &settings)
+				assert.NoError(err)
+				assert.True(res.Converged(), res.Status.String())
+				assert.InDeltaSlice(test.want, res.X, 1e-3)
+				assert.InDelta(0, res.F, 1e-6)
+			})
+		}
+	}
+}
+
+func TestMinimizeErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := optimize.BFGS(optimize.Problem{}, algebraic.NewVector(1, 1), nil)
+	assert.ErrorIs(err, optimize.ErrNoFunc)
+
+	_, err = optimize.NelderMead(quadratic, algebraic.NewZeroVector(0), nil)
+	assert.ErrorIs(err, optimize.ErrInsufficientDim)
+
+	p := rosenbrock
+	p.Grad = func(x algebraic.Vector) algebraic.Vector { return algebraic.NewZeroVector(3) }
+	_, err = optimize.GradientDescent(p, algebraic.NewVector(2, 0, 0), nil)
+	assert.ErrorIs(err, optimize.ErrDimMismatch)
+}
+
+func TestIterationLimit(t *testing.T) {
+	assert := assert.New(t)
+	settings := optimize.DefaultSettings
+	settings.MaxIter = 5
+
+	res, err := optimize.GradientDescent(rosenbrock, algebraic.NewVector(2, -1.2, 1), &settings)
+	assert.NoError(err)
+	assert.Equal(optimize.IterationLimit, res.Status)
+	assert.Equal(5, res.Iterations)
+	assert.False(res.Converged())
+}
+
+func TestNumericalGradient(t *testing.T) {
+	assert := assert.New(t)
+	x := algebraic.NewVector(2, -1.2, 1)
+
+	got := optimize.NumericalGradient(rosenbrock.Func, x)
+	assert.InDeltaSlice(rosenbrock.Grad(x), got, 1e-5)
+
+	h := optimize.NumericalHessian(rosenbrock.Grad, x)
+	want := rosenbrock.Hess(x)
+	for i := range want {
+		assert.InDeltaSlice(want[i], h[i], 1e-4)
+	}
+}
+
+func TestPartialSettings(t *testing.T) {
+	assert := assert.New(t)
+
+	methods := map[string]method{
+		"gradient descent": optimize.GradientDescent,
+		"newton":           optimize.Newton,
+		"bfgs":             optimize.BFGS,
+		"l-bfgs":           optimize.LBFGS,
+		"nelder-mead":      optimize.NelderMead,
+	}
+
+	for mname, m := range methods {
+		t.Run("should fill zero settings from the defaults using "+mname, func(t *testing.T) {
+			s := &optimize.Settings{GradTol: 1e-8}
+			res, err := m(quadratic, algebraic.NewVector(3, 0, 0, 0), s)
+			assert.NoError(err)
+			assert.True(res.Converged(), res.Status.String())
+			assert.Positive(res.Iterations)
+			assert.InDeltaSlice(algebraic.NewVector(3, 1, -2, 3), res.X, 1e-3)
+
+			// the settings given are left untouched
+			assert.Equal(optimize.Settings{GradTol: 1e-8}, *s)
+		})
+	}
+}
+
+func TestInvalidSettings(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		s optimize.Settings
+	}{
+		"should return error for a negative gradient tolerance": {
+			s: optimize.Settings{GradTol: -1},
+		},
+		"should return error for a negative function tolerance": {
+			s: optimize.Settings{FuncTol: -1},
+		},
+		"should return error for a negative iteration limit": {
+			s: optimize.Settings{MaxIter: -1},
+		},
+		"should return error for a negative memory": {
+			s: optimize.Settings{Memory: -1},
+		},
+		"should return error for a negative step size": {
+			s: optimize.Settings{Step: -1},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := optimize.BFGS(rosenbrock, algebraic.NewVector(2, -1.2, 1), &test.s)
+			assert.ErrorIs(err, optimize.ErrInvalidSettings)
+			assert.Nil(res)
+		})
+	}
+}
+
+func TestNumericalHessianGradientError(t *testing.T) {
+	assert := assert.New(t)
+	p := rosenbrock
+	p.Hess = nil
+
+	// the gradient is well formed at the initial point only, so the error shows
+	// up while the Hessian is approximated
+	x0 := algebraic.NewVector(2, -1.2, 1)
+	p.Grad = func(x algebraic.Vector) algebraic.Vector {
+		if x[0] == x0[0] && x[1] == x0[1] {
+			return rosenbrock.Grad(x)
+		}
+		return algebraic.NewZeroVector(3)
+	}
+
+	res, err := optimize.Newton(p, x0, nil)
+	assert.ErrorIs(err, optimize.ErrDimMismatch)
+	assert.Nil(res)
+}
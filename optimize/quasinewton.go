@@ -0,0 +1,202 @@
+package optimize
+
+import (
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// BFGS minimizes the problem p from the initial point x0 by the
+// Broyden-Fletcher-Goldfarb-Shanno quasi-Newton method. Rather than computing
+// the Hessian, an approximation of its inverse is built up from the changes in
+// the gradient between iterations:
+//
+//	H' = (I - ρ·s·yᵀ)·H·(I - ρ·y·sᵀ) + ρ·s·sᵀ,  ρ = 1/(yᵀ·s)
+//
+// where s is the step taken and y the change in gradient. The update is skipped
+// whenever yᵀ·s is not positive, which keeps H positive definite. Convergence
+// is superlinear, while only gradients are required. The approximation takes
+// O(n²) memory, see LBFGS for large problems. If s is nil, DefaultSettings is
+// used.
+func BFGS(p Problem, x0 algebraic.Vector, s *Settings) (*Result, error) {
+	e, set, err := newEvaluator(p, x0, s)
+	if err != nil {
+		return nil, err
+	}
+
+	n := x0.Dimension()
+	x := clone(x0)
+	fx := e.f(x)
+	g, err := e.grad(x)
+	if err != nil {
+		return nil, err
+	}
+
+	h := algebraic.NewIdentityMatrix(n, n)
+	first := true
+
+	for iter := range set.MaxIter {
+		if normInf(g) < set.GradTol {
+			return e.result(x, fx, g, GradientConverged, iter), nil
+		}
+
+		d, _ := h.MulVec(g)
+		d.Scale(-1)
+		if slope, _ := g.Dot(d); slope >= 0 {
+			// reset the approximation if it lost positive definiteness
+			h = algebraic.NewIdentityMatrix(n, n)
+			d = clone(g)
+			d.Scale(-1)
+		}
+
+		xn, fn, _, err := e.backtrack(x, d, fx, g, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		gn, err := e.grad(xn)
+		if err != nil {
+			return nil, err
+		}
+
+		sv := clone(xn)
+		sv.Sub(x)
+		yv := clone(gn)
+		yv.Sub(g)
+
+		if ys, _ := yv.Dot(sv); ys > 0 {
+			if first {
+				// scale the initial approximation to the curvature along s
+				yy, _ := yv.Dot(yv)
+				h = algebraic.NewIdentityMatrix(n, n)
+				for i := range h {
+					h[i][i] = ys / yy
+				}
+
+				first = false
+			}
+
+			bfgsUpdate(h, sv, yv, 1/ys)
+		}
+
+		x, fx, g = xn, fn, gn
+	}
+
+	if normInf(g) < set.GradTol {
+		return e.result(x, fx, g, GradientConverged, set.MaxIter), nil
+	}
+
+	return e.result(x, fx, g, IterationLimit, set.MaxIter), nil
+}
+
+// bfgsUpdate applies the BFGS update of the inverse Hessian approximation h in
+// place, in O(n²) time, using the expanded form:
+//
+//	H' = H - ρ·(s·(Hy)ᵀ + (Hy)·sᵀ) + (ρ²·yᵀHy + ρ)·s·sᵀ
+func bfgsUpdate(h algebraic.Matrix, s, y algebraic.Vector, rho float64) {
+	hy, _ := h.MulVec(y)
+	yhy, _ := y.Dot(hy)
+	c := rho*rho*yhy + rho
+
+	for i := range h {
+		for j := range h[i] {
+			h[i][j] += -rho*(s[i]*hy[j]+hy[i]*s[j]) + c*s[i]*s[j]
+		}
+	}
+}
+
+// LBFGS minimizes the problem p from the initial point x0 by the limited
+// memory BFGS method. Instead of storing the inverse Hessian approximation,
+// only the last Memory pairs of steps and gradient changes are kept, and the
+// search direction is computed from them by the two-loop recursion. This takes
+// O(m·n) memory and time per iteration, which makes it suitable for problems
+// with many variables. If s is nil, DefaultSettings is used.
+func LBFGS(p Problem, x0 algebraic.Vector, s *Settings) (*Result, error) {
+	e, set, err := newEvaluator(p, x0, s)
+	if err != nil {
+		return nil, err
+	}
+
+	m := max(set.Memory, 1)
+	x := clone(x0)
+	fx := e.f(x)
+	g, err := e.grad(x)
+	if err != nil {
+		return nil, err
+	}
+
+	var ss, ys []algebraic.Vector
+	var rhos []float64
+
+	for iter := range set.MaxIter {
+		if normInf(g) < set.GradTol {
+			return e.result(x, fx, g, GradientConverged, iter), nil
+		}
+
+		d := twoLoop(g, ss, ys, rhos)
+		if slope, _ := g.Dot(d); slope >= 0 {
+			ss, ys, rhos = nil, nil, nil
+			d = clone(g)
+			d.Scale(-1)
+		}
+
+		xn, fn, _, err := e.backtrack(x, d, fx, g, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		gn, err := e.grad(xn)
+		if err != nil {
+			return nil, err
+		}
+
+		sv := clone(xn)
+		sv.Sub(x)
+		yv := clone(gn)
+		yv.Sub(g)
+
+		if ysv, _ := yv.Dot(sv); ysv > 0 {
+			if len(ss) == m {
+				ss, ys, rhos = ss[1:], ys[1:], rhos[1:]
+			}
+
+			ss = append(ss, sv)
+			ys = append(ys, yv)
+			rhos = append(rhos, 1/ysv)
+		}
+
+		x, fx, g = xn, fn, gn
+	}
+
+	if normInf(g) < set.GradTol {
+		return e.result(x, fx, g, GradientConverged, set.MaxIter), nil
+	}
+
+	return e.result(x, fx, g, IterationLimit, set.MaxIter), nil
+}
+
+// twoLoop computes the L-BFGS search direction -H·g from the stored pairs of
+// steps and gradient changes, ordered from oldest to newest.
+func twoLoop(g algebraic.Vector, ss, ys []algebraic.Vector, rhos []float64) algebraic.Vector {
+	q := clone(g)
+	as := make([]float64, len(ss))
+
+	for i := len(ss) - 1; i >= 0; i-- {
+		sq, _ := ss[i].Dot(q)
+		as[i] = rhos[i] * sq
+		q = axpy(q, -as[i], ys[i])
+	}
+
+	// scale by the curvature of the newest pair
+	if k := len(ss) - 1; k >= 0 {
+		yy, _ := ys[k].Dot(ys[k])
+		q.Scale(1 / (rhos[k] * yy))
+	}
+
+	for i := range ss {
+		yq, _ := ys[i].Dot(q)
+		b := rhos[i] * yq
+		q = axpy(q, as[i]-b, ss[i])
+	}
+
+	q.Scale(-1)
+	return q
+}
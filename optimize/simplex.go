@@ -0,0 +1,488 @@
+package optimize
+
+import (
+	"errors"
+	"math"
+
+	"github.com/madshov/data-structures/algebraic"
+)
+
+// Various errors the simplex method can return.
+var (
+	ErrLPShape  = errors.New("linear program dimensions are not consistent")
+	ErrLPBounds = errors.New("lower bound is greater than upper bound")
+)
+
+// lpEps is the tolerance used to decide if a coordinate of the tableau is zero.
+const lpEps = 1e-9
+
+// Constraint represents the relation of a linear constraint aᵢ·x to bᵢ.
+type Constraint int
+
+const (
+	LessEqual Constraint = iota
+	GreaterEqual
+	Equal
+)
+
+// LinearProgram defines a linear program in the form
+//
+//	minimize    c·x
+//	subject to  aᵢ·x (≤, ≥ or =) bᵢ  for each row aᵢ of A
+//	            lower ≤ x ≤ upper
+//
+// Kinds holds the relation of each constraint, and defaults to LessEqual for
+// all of them if nil. Lower defaults to 0 for all variables if nil, and Upper
+// to +Inf. A lower bound of -Inf makes a variable free. Setting Maximize
+// maximizes c·x instead.
+type LinearProgram struct {
+	C        algebraic.Vector
+	A        algebraic.Matrix
+	B        algebraic.Vector
+	Kinds    []Constraint
+	Lower    algebraic.Vector
+	Upper    algebraic.Vector
+	Maximize bool
+}
+
+// LPStatus represents the outcome of solving a linear program.
+type LPStatus int
+
+const (
+	Optimal LPStatus = iota
+	Infeasible
+	Unbounded
+)
+
+// String returns a description of the status.
+func (s LPStatus) String() string {
+	switch s {
+	case Optimal:
+		return "optimal"
+	case Infeasible:
+		return "infeasible"
+	default:
+		return "unbounded"
+	}
+}
+
+// LPResult defines the outcome of solving a linear program, with the optimal
+// point and objective value if the status is Optimal.
+type LPResult struct {
+	Status     LPStatus
+	X          algebraic.Vector
+	Value      float64
+	Iterations int
+}
+
+// Simplex solves a linear program using the dense two-phase simplex method.
+// The program is first brought on standard form, i.e. all variables are
+// shifted or split to be non-negative, upper bounds are added as constraints,
+// and each constraint gets a slack, surplus and/or artificial variable, so
+// that an initial basic feasible solution is readily available. Phase one
+// minimizes the sum of the artificial variables - if it cannot be brought to
+// zero, the program is infeasible. Phase two then minimizes the objective
+// from the feasible basis found. Pivots follow Bland's rule, i.e. the entering
+// and leaving variables are always those of lowest index among the
+// candidates, which guarantees termination by preventing cycling.
+func Simplex(lp LinearProgram) (*LPResult, error) {
+	n := len(lp.C)
+	m := len(lp.A)
+
+	if n == 0 || len(lp.B) != m ||
+		(lp.Kinds != nil && len(lp.Kinds) != m) ||
+		(lp.Lower != nil && len(lp.Lower) != n) ||
+		(lp.Upper != nil && len(lp.Upper) != n) {
+		return nil, ErrLPShape
+	}
+
+	for _, a := range lp.A {
+		if len(a) != n {
+			return nil, ErrLPShape
+		}
+	}
+
+	sf, err := newStandardForm(lp)
+	if err != nil {
+		return nil, err
+	}
+
+	tb := sf.tableau()
+
+	// phase one: minimize the sum of the artificial variables
+	cost := make([]float64, tb.cols)
+	for j := sf.artificial; j < tb.cols; j++ {
+		cost[j] = 1
+	}
+
+	tb.setObjective(cost)
+	iter, _ := tb.run(tb.cols)
+	if tb.value() > lpEps*math.Max(1, normInf(sf.b)) {
+		return &LPResult{Status: Infeasible, Iterations: iter}, nil
+	}
+
+	tb.dropArtificial(sf.artificial)
+
+	// phase two: minimize the objective without the artificial variables
+	cost = make([]float64, tb.cols)
+	copy(cost, sf.c)
+	tb.setObjective(cost)
+
+	it, unbounded := tb.run(sf.artificial)
+	iter += it
+	if unbounded {
+		return &LPResult{Status: Unbounded, Iterations: iter}, nil
+	}
+
+	x := sf.recover(tb.solution())
+	val, _ := lp.C.Dot(x)
+
+	return &LPResult{
+		Status:     Optimal,
+		X:          x,
+		Value:      val,
+		Iterations: iter,
+	}, nil
+}
+
+// standardForm defines a linear program on the form: minimize c·y subject to
+// A·y = b and y ≥ 0 with b ≥ 0, along with the mapping back to the original
+// variables. Columns from artificial onwards are artificial variables.
+type standardForm struct {
+	c          []float64
+	a          [][]float64
+	b          algebraic.Vector
+	basis      []int
+	artificial int
+
+	// x = offset + Σ sign·y[col] for each original variable
+	offset []float64
+	cols   [][]int
+	signs  [][]float64
+}
+
+// newStandardForm transforms a linear program to standard form.
+func newStandardForm(lp LinearProgram) (*standardForm, error) {
+	n := len(lp.C)
+	sf := &standardForm{
+		offset: make([]float64, n),
+		cols:   make([][]int, n),
+		signs:  make([][]float64, n),
+	}
+
+	sign := 1.0
+	if lp.Maximize {
+		sign = -1
+	}
+
+	// map each original variable onto non-negative variables
+	var ny int
+	type bound struct {
+		col int
+		val float64
+	}
+	var uppers []bound
+
+	for j := range n {
+		lo, up := 0.0, math.Inf(1)
+		if lp.Lower != nil {
+			lo = lp.Lower[j]
+		}
+
+		if lp.Upper != nil {
+			up = lp.Upper[j]
+		}
+
+		if lo > up {
+			return nil, ErrLPBounds
+		}
+
+		switch {
+		case !math.IsInf(lo, -1):
+			// x = lo + y, y ≤ up - lo
+			sf.offset[j] = lo
+			sf.cols[j] = []int{ny}
+			sf.signs[j] = []float64{1}
+			if !math.IsInf(up, 1) {
+				uppers = append(uppers, bound{ny, up - lo})
+			}
+			ny++
+		case !math.IsInf(up, 1):
+			// x = up - y
+			sf.offset[j] = up
+			sf.cols[j] = []int{ny}
+			sf.signs[j] = []float64{-1}
+			ny++
+		default:
+			// x = y⁺ - y⁻
+			sf.cols[j] = []int{ny, ny + 1}
+			sf.signs[j] = []float64{1, -1}
+			ny += 2
+		}
+	}
+
+	// build the rows in terms of y, moving the offsets to the right hand side
+	type row struct {
+		a    []float64
+		b    float64
+		kind Constraint
+	}
+
+	var rows []row
+	for i, ai := range lp.A {
+		r := row{a: make([]float64, ny), b: lp.B[i]}
+		if lp.Kinds != nil {
+			r.kind = lp.Kinds[i]
+		}
+
+		for j, c := range ai {
+			r.b -= c * sf.offset[j]
+			for k, col := range sf.cols[j] {
+				r.a[col] += c * sf.signs[j][k]
+			}
+		}
+
+		rows = append(rows, r)
+	}
+
+	for _, u := range uppers {
+		r := row{a: make([]float64, ny), b: u.val, kind: LessEqual}
+		r.a[u.col] = 1
+		rows = append(rows, r)
+	}
+
+	// ensure a non-negative right hand side
+	for i := range rows {
+		if rows[i].b < 0 {
+			rows[i].b = -rows[i].b
+			for k := range rows[i].a {
+				rows[i].a[k] = -rows[i].a[k]
+			}
+
+			switch rows[i].kind {
+			case LessEqual:
+				rows[i].kind = GreaterEqual
+			case GreaterEqual:
+				rows[i].kind = LessEqual
+			}
+		}
+	}
+
+	// count slack, surplus and artificial variables
+	var slacks, arts int
+	for _, r := range rows {
+		if r.kind != Equal {
+			slacks++
+		}
+
+		if r.kind != LessEqual {
+			arts++
+		}
+	}
+
+	total := ny + slacks + arts
+	sf.artificial = ny + slacks
+	sf.c = make([]float64, total)
+	sf.b = algebraic.NewZeroVector(uint(len(rows)))
+
+	for j := range n {
+		for k, col := range sf.cols[j] {
+			sf.c[col] += sign * lp.C[j] * sf.signs[j][k]
+		}
+	}
+
+	s, a := ny, sf.artificial
+	for i, r := range rows {
+		ar := make([]float64, total)
+		copy(ar, r.a)
+		sf.b[i] = r.b
+
+		switch r.kind {
+		case LessEqual:
+			ar[s] = 1
+			sf.basis = append(sf.basis, s)
+			s++
+		case GreaterEqual:
+			ar[s] = -1
+			ar[a] = 1
+			sf.basis = append(sf.basis, a)
+			s++
+			a++
+		case Equal:
+			ar[a] = 1
+			sf.basis = append(sf.basis, a)
+			a++
+		}
+
+		sf.a = append(sf.a, ar)
+	}
+
+	return sf, nil
+}
+
+// tableau creates the initial simplex tableau of the standard form.
+func (sf *standardForm) tableau() *tableau {
+	cols := len(sf.c)
+	tb := &tableau{
+		rows:  len(sf.a),
+		cols:  cols,
+		basis: append([]int(nil), sf.basis...),
+	}
+
+	for i, ar := range sf.a {
+		r := algebraic.NewVector(uint(cols+1), ar...)
+		r[cols] = sf.b[i]
+		tb.t = append(tb.t, r)
+	}
+
+	tb.t = append(tb.t, algebraic.NewZeroVector(uint(cols+1)))
+	return tb
+}
+
+// recover maps a solution of the standard form back to the original variables.
+func (sf *standardForm) recover(y []float64) algebraic.Vector {
+	x := algebraic.NewZeroVector(uint(len(sf.offset)))
+	for j := range x {
+		x[j] = sf.offset[j]
+		for k, col := range sf.cols[j] {
+			x[j] += sf.signs[j][k] * y[col]
+		}
+	}
+
+	return x
+}
+
+// tableau defines a simplex tableau with a row per constraint followed by the
+// objective row of reduced costs, and a column per variable followed by the
+// right hand side. The basis holds the basic variable of each constraint row.
+type tableau struct {
+	t     algebraic.Matrix
+	rows  int
+	cols  int
+	basis []int
+}
+
+// setObjective sets the objective row to a given cost vector, and brings it on
+// canonical form by eliminating the costs of the basic variables.
+func (tb *tableau) setObjective(cost []float64) {
+	obj := tb.t[tb.rows]
+	for j := range obj {
+		obj[j] = 0
+	}
+	copy(obj, cost)
+
+	for i, b := range tb.basis {
+		if cb := obj[b]; cb != 0 {
+			for j := range obj {
+				obj[j] -= cb * tb.t[i][j]
+			}
+		}
+	}
+}
+
+// value returns the objective value of the current basic solution.
+func (tb *tableau) value() float64 {
+	return -tb.t[tb.rows][tb.cols]
+}
+
+// run performs simplex pivots by Bland's rule until the current basis is
+// optimal, considering only columns below a given limit for entering the
+// basis. It returns the number of pivots, and whether the objective is
+// unbounded.
+func (tb *tableau) run(limit int) (int, bool) {
+	obj := tb.t[tb.rows]
+
+	for iter := 0; ; iter++ {
+		// entering variable: lowest index with negative reduced cost
+		e := -1
+		for j := range limit {
+			if obj[j] < -lpEps {
+				e = j
+				break
+			}
+		}
+
+		if e < 0 {
+			return iter, false
+		}
+
+		// leaving variable: minimum ratio, ties broken by lowest index
+		l := -1
+		var best float64
+		for i := range tb.rows {
+			if tb.t[i][e] <= lpEps {
+				continue
+			}
+
+			r := tb.t[i][tb.cols] / tb.t[i][e]
+			if l < 0 || r < best-lpEps || (r <= best+lpEps && tb.basis[i] < tb.basis[l]) {
+				l, best = i, r
+			}
+		}
+
+		if l < 0 {
+			return iter, true
+		}
+
+		tb.pivot(l, e)
+	}
+}
+
+// pivot makes the variable of column c basic in row r.
+func (tb *tableau) pivot(r, c int) {
+	pr := tb.t[r]
+	pr.Scale(1 / pr[c])
+
+	for i := range tb.t {
+		if i == r {
+			continue
+		}
+
+		if f := tb.t[i][c]; f != 0 {
+			for j := range pr {
+				tb.t[i][j] -= f * pr[j]
+			}
+		}
+	}
+
+	tb.basis[r] = c
+}
+
+// dropArtificial pivots any artificial variable left in the basis after phase
+// one out of it. If no non-artificial variable can replace it, the constraint
+// is redundant and its row is removed.
+func (tb *tableau) dropArtificial(artificial int) {
+	for i := 0; i < tb.rows; i++ {
+		if tb.basis[i] < artificial {
+			continue
+		}
+
+		c := -1
+		for j := range artificial {
+			if math.Abs(tb.t[i][j]) > lpEps {
+				c = j
+				break
+			}
+		}
+
+		if c >= 0 {
+			tb.pivot(i, c)
+			continue
+		}
+
+		tb.t = append(tb.t[:i], tb.t[i+1:]...)
+		tb.basis = append(tb.basis[:i], tb.basis[i+1:]...)
+		tb.rows--
+		i--
+	}
+}
+
+// solution returns the current basic solution.
+func (tb *tableau) solution() []float64 {
+	y := make([]float64, tb.cols)
+	for i, b := range tb.basis {
+		y[b] = tb.t[i][tb.cols]
+	}
+
+	return y
+}
@@ -0,0 +1,167 @@
+package optimize_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/algebraic"
+	"github.com/madshov/data-structures/optimize"
+)
+
+func TestSimplex(t *testing.T) {
+	assert := assert.New(t)
+	tests := map[string]struct {
+		lp         optimize.LinearProgram
+		wantStatus optimize.LPStatus
+		wantX      algebraic.Vector
+		wantValue  float64
+	}{
+		"should maximize a production plan": {
+			// maximize 3x + 5y st. x ≤ 4, 2y ≤ 12, 3x + 2y ≤ 18
+			lp: optimize.LinearProgram{
+				C: algebraic.NewVector(2, 3, 5),
+				A: algebraic.NewMatrix(3, 2,
+					1, 0,
+					0, 2,
+					3, 2,
+				),
+				B:        algebraic.NewVector(3, 4, 12, 18),
+				Maximize: true,
+			},
+			wantStatus: optimize.Optimal,
+			wantX:      algebraic.NewVector(2, 2, 6),
+			wantValue:  36,
+		},
+		"should minimize a diet with greater-equal constraints": {
+			// minimize 0.6x + y st. 10x + 4y ≥ 20, 5x + 5y ≥ 20, 2x + 6y ≥ 12
+			lp: optimize.LinearProgram{
+				C: algebraic.NewVector(2, 0.6, 1),
+				A: algebraic.NewMatrix(3, 2,
+					10, 4,
+					5, 5,
+					2, 6,
+				),
+				B: algebraic.NewVector(3, 20, 20, 12),
+				Kinds: []optimize.Constraint{
+					optimize.GreaterEqual,
+					optimize.GreaterEqual,
+					optimize.GreaterEqual,
+				},
+			},
+			wantStatus: optimize.Optimal,
+			wantX:      algebraic.NewVector(2, 3, 1),
+			wantValue:  2.8,
+		},
+		"should handle equality constraints and bounds": {
+			// minimize x - y st. x + y = 4, 1 ≤ x ≤ 3, -∞ < y ≤ 2
+			lp: optimize.LinearProgram{
+				C:     algebraic.NewVector(2, 1, -1),
+				A:     algebraic.NewMatrix(1, 2, 1, 1),
+				B:     algebraic.NewVector(1, 4),
+				Kinds: []optimize.Constraint{optimize.Equal},
+				Lower: algebraic.NewVector(2, 1, math.Inf(-1)),
+				Upper: algebraic.NewVector(2, 3, 2),
+			},
+			wantStatus: optimize.Optimal,
+			wantX:      algebraic.NewVector(2, 2, 2),
+			wantValue:  0,
+		},
+		"should handle free variables": {
+			// minimize x st. x ≥ -5 as a constraint, with x free
+			lp: optimize.LinearProgram{
+				C:     algebraic.NewVector(1, 1),
+				A:     algebraic.NewMatrix(1, 1, 1),
+				B:     algebraic.NewVector(1, -5),
+				Kinds: []optimize.Constraint{optimize.GreaterEqual},
+				Lower: algebraic.NewVector(1, math.Inf(-1)),
+			},
+			wantStatus: optimize.Optimal,
+			wantX:      algebraic.NewVector(1, -5),
+			wantValue:  -5,
+		},
+		"should handle redundant equality constraints": {
+			// minimize x + y st. x + y = 2, 2x + 2y = 4
+			lp: optimize.LinearProgram{
+				C: algebraic.NewVector(2, 1, 2),
+				A: algebraic.NewMatrix(2, 2,
+					1, 1,
+					2, 2,
+				),
+				B:     algebraic.NewVector(2, 2, 4),
+				Kinds: []optimize.Constraint{optimize.Equal, optimize.Equal},
+			},
+			wantStatus: optimize.Optimal,
+			wantX:      algebraic.NewVector(2, 2, 0),
+			wantValue:  2,
+		},
+		"should report an infeasible program": {
+			// x ≤ 1 and x ≥ 2
+			lp: optimize.LinearProgram{
+				C:     algebraic.NewVector(1, 1),
+				A:     algebraic.NewMatrix(2, 1, 1, 1),
+				B:     algebraic.NewVector(2, 1, 2),
+				Kinds: []optimize.Constraint{optimize.LessEqual, optimize.GreaterEqual},
+			},
+			wantStatus: optimize.Infeasible,
+		},
+		"should report an unbounded program": {
+			// maximize x + y st. x - y ≤ 1
+			lp: optimize.LinearProgram{
+				C:        algebraic.NewVector(2, 1, 1),
+				A:        algebraic.NewMatrix(1, 2, 1, -1),
+				B:        algebraic.NewVector(1, 1),
+				Maximize: true,
+			},
+			wantStatus: optimize.Unbounded,
+		},
+		"should terminate on a degenerate program known to cycle without bland's rule": {
+			// Beale's example
+			lp: optimize.LinearProgram{
+				C: algebraic.NewVector(4, -0.75, 150, -0.02, 6),
+				A: algebraic.NewMatrix(3, 4,
+					0.25, -60, -0.04, 9,
+					0.5, -90, -0.02, 3,
+					0, 0, 1, 0,
+				),
+				B: algebraic.NewVector(3, 0, 0, 1),
+			},
+			wantStatus: optimize.Optimal,
+			wantX:      algebraic.NewVector(4, 0.04, 0, 1, 0),
+			wantValue:  -0.05,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := optimize.Simplex(test.lp)
+			assert.NoError(err)
+			assert.Equal(test.wantStatus, res.Status, res.Status.String())
+			if test.wantStatus == optimize.Optimal {
+				assert.InDeltaSlice(test.wantX, res.X, 1e-9)
+				assert.InDelta(test.wantValue, res.Value, 1e-9)
+			}
+		})
+	}
+}
+
+func TestSimplexErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := optimize.Simplex(optimize.LinearProgram{
+		C: algebraic.NewVector(2, 1, 1),
+		A: algebraic.NewMatrix(1, 3, 1, 1, 1),
+		B: algebraic.NewVector(1, 1),
+	})
+	assert.ErrorIs(err, optimize.ErrLPShape)
+
+	_, err = optimize.Simplex(optimize.LinearProgram{
+		C:     algebraic.NewVector(1, 1),
+		A:     algebraic.NewMatrix(1, 1, 1),
+		B:     algebraic.NewVector(1, 1),
+		Lower: algebraic.NewVector(1, 2),
+		Upper: algebraic.NewVector(1, 1),
+	})
+	assert.ErrorIs(err, optimize.ErrLPBounds)
+}
@@ -2,6 +2,7 @@ package tree
 
 import (
 	"fmt"
+	"iter"
 )
 
 // BSTree defines a tree structure with a root node and node count.
@@ -577,6 +578,90 @@ func (t *BSTree) PostOrderIt(f func(*BSNode)) {
 	}
 }
 
+// All returns an iterator over the nodes of the tree in ascending order of
+// their values. The iteration starts at the minimum node and moves on to the
+// successor of each node, so no extra storage is needed, and the iteration
+// can be stopped early at no cost.
+func (t *BSTree) All() iter.Seq[*BSNode] {
+	return t.ascend(func() *BSNode { return t.MinIt(t.root) })
+}
+
+// Backward returns an iterator over the nodes of the tree in descending order
+// of their values, by moving on to the predecessor of each node.
+func (t *BSTree) Backward() iter.Seq[*BSNode] {
+	return t.descend(func() *BSNode { return t.MaxIt(t.root) })
+}
+
+// Ascend returns an iterator over the nodes of the tree with a value greater
+// than or equal to a given value, in ascending order of their values.
+func (t *BSTree) Ascend(from int) iter.Seq[*BSNode] {
+	return t.ascend(func() *BSNode { return t.ceiling(from) })
+}
+
+// Descend returns an iterator over the nodes of the tree with a value less
+// than or equal to a given value, in descending order of their values.
+func (t *BSTree) Descend(from int) iter.Seq[*BSNode] {
+	return t.descend(func() *BSNode { return t.floor(from) })
+}
+
+// ascend returns an iterator over the nodes of the tree from the node returned
+// by start and its successors. The start node is looked up each time the
+// iterator is ranged over, so every iteration starts from the current tree.
+func (t *BSTree) ascend(start func() *BSNode) iter.Seq[*BSNode] {
+	return func(yield func(*BSNode) bool) {
+		for m := start(); m != nil; m, _ = t.SuccessorIt(m) {
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+// descend returns an iterator over the nodes of the tree from the node returned
+// by start and its predecessors. As for ascend, start is called each time the
+// iterator is ranged over.
+func (t *BSTree) descend(start func() *BSNode) iter.Seq[*BSNode] {
+	return func(yield func(*BSNode) bool) {
+		for m := start(); m != nil; m, _ = t.PredecessorIt(m) {
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+// ceiling returns the least node with a value not less than v, or nil if there
+// is none.
+func (t *BSTree) ceiling(v int) *BSNode {
+	var m *BSNode
+	for n := t.root; n != nil; {
+		if n.Value >= v {
+			m = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return m
+}
+
+// floor returns the greatest node with a value not greater than v, or nil if
+// there is none.
+func (t *BSTree) floor(v int) *BSNode {
+	var m *BSNode
+	for n := t.root; n != nil; {
+		if n.Value <= v {
+			m = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return m
+}
+
 // Root returns the root of the tree.
 func (t *BSTree) Root() *BSNode {
 	return t.root
@@ -0,0 +1,223 @@
+package tree_test
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/madshov/data-structures/tree"
+)
+
+// take ranges over a sequence of nodes, and returns the values of its first n
+// nodes, or of all of them if n is negative. The range is stopped early once n
+// values are taken.
+func take[N any](seq iter.Seq[N], value func(N) int, n int) []int {
+	vals := []int{}
+	if n == 0 {
+		return vals
+	}
+
+	for nd := range seq {
+		vals = append(vals, value(nd))
+		if len(vals) == n {
+			break
+		}
+	}
+
+	return vals
+}
+
+func TestBSTreeIterators(t *testing.T) {
+	assert := assert.New(t)
+	bst := tree.NewBSTree()
+	for _, v := range []int{15, 6, 18, 3, 7, 17, 20, 2, 4, 13, 9} {
+		bst.Insert(v)
+	}
+
+	tests := map[string]struct {
+		seq  iter.Seq[*tree.BSNode]
+		want []int
+	}{
+		"should range over an empty tree": {
+			seq:  tree.NewBSTree().All(),
+			want: []int{},
+		},
+		"should range over an empty tree backwards": {
+			seq:  tree.NewBSTree().Backward(),
+			want: []int{},
+		},
+		"should range over all nodes in ascending order": {
+			seq:  bst.All(),
+			want: []int{2, 3, 4, 6, 7, 9, 13, 15, 17, 18, 20},
+		},
+		"should range over all nodes in descending order": {
+			seq:  bst.Backward(),
+			want: []int{20, 18, 17, 15, 13, 9, 7, 6, 4, 3, 2},
+		},
+		"should ascend from an existing value": {
+			seq:  bst.Ascend(13),
+			want: []int{13, 15, 17, 18, 20},
+		},
+		"should ascend from a missing value": {
+			seq:  bst.Ascend(10),
+			want: []int{13, 15, 17, 18, 20},
+		},
+		"should ascend from past the maximum": {
+			seq:  bst.Ascend(21),
+			want: []int{},
+		},
+		"should descend from an existing value": {
+			seq:  bst.Descend(6),
+			want: []int{6, 4, 3, 2},
+		},
+		"should descend from a missing value": {
+			seq:  bst.Descend(16),
+			want: []int{15, 13, 9, 7, 6, 4, 3, 2},
+		},
+		"should descend from before the minimum": {
+			seq:  bst.Descend(1),
+			want: []int{},
+		},
+	}
+
+	value := func(n *tree.BSNode) int { return n.Value }
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.want, take(test.seq, value, -1))
+
+			// stop early, after the first two nodes
+			assert.Equal(test.want[:min(2, len(test.want))], take(test.seq, value, 2))
+
+			// the same sequence can be ranged over again
+			assert.Equal(test.want, take(test.seq, value, -1))
+		})
+	}
+}
+
+func TestRBTreeIterators(t *testing.T) {
+	assert := assert.New(t)
+	rbt := tree.NewRBTree()
+	for i := 1; i <= 20; i++ {
+		rbt.Insert(i * 5)
+	}
+
+	tests := map[string]struct {
+		seq  iter.Seq[*tree.RBNode]
+		want []int
+	}{
+		"should range over an empty tree": {
+			seq:  tree.NewRBTree().All(),
+			want: []int{},
+		},
+		"should range over an empty tree backwards": {
+			seq:  tree.NewRBTree().Backward(),
+			want: []int{},
+		},
+		"should range over all nodes in ascending order": {
+			seq: rbt.All(),
+			want: []int{
+				5, 10, 15, 20, 25, 30, 35, 40, 45, 50,
+				55, 60, 65, 70, 75, 80, 85, 90, 95, 100,
+			},
+		},
+		"should range over all nodes in descending order": {
+			seq: rbt.Backward(),
+			want: []int{
+				100, 95, 90, 85, 80, 75, 70, 65, 60, 55,
+				50, 45, 40, 35, 30, 25, 20, 15, 10, 5,
+			},
+		},
+		"should ascend from an existing value": {
+			seq:  rbt.Ascend(80),
+			want: []int{80, 85, 90, 95, 100},
+		},
+		"should ascend from a missing value": {
+			seq:  rbt.Ascend(81),
+			want: []int{85, 90, 95, 100},
+		},
+		"should ascend from past the maximum": {
+			seq:  rbt.Ascend(101),
+			want: []int{},
+		},
+		"should descend from an existing value": {
+			seq:  rbt.Descend(20),
+			want: []int{20, 15, 10, 5},
+		},
+		"should descend from a missing value": {
+			seq:  rbt.Descend(24),
+			want: []int{20, 15, 10, 5},
+		},
+		"should descend from before the minimum": {
+			seq:  rbt.Descend(4),
+			want: []int{},
+		},
+	}
+
+	value := func(n *tree.RBNode) int { return n.Value }
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(test.want, take(test.seq, value, -1))
+
+			// stop early, after the first two nodes
+			assert.Equal(test.want[:min(2, len(test.want))], take(test.seq, value, 2))
+
+			// the same sequence can be ranged over again
+			assert.Equal(test.want, take(test.seq, value, -1))
+		})
+	}
+}
+
+func TestBSTreeIteratorsAfterChange(t *testing.T) {
+	assert := assert.New(t)
+	bst := tree.NewBSTree()
+	for _, v := range []int{20, 10, 40, 30, 50} {
+		bst.Insert(v)
+	}
+
+	all, backward := bst.All(), bst.Backward()
+	ascend, descend := bst.Ascend(30), bst.Descend(30)
+
+	// the sequences are created before the tree changes, so the nodes they
+	// would have started from are either deleted or no longer the first
+	bst.Insert(5)
+	for _, v := range []int{10, 30, 50} {
+		n, ok := bst.Search(bst.Root(), v)
+		assert.True(ok)
+		bst.Delete(n)
+	}
+	bst.Insert(55)
+
+	value := func(n *tree.BSNode) int { return n.Value }
+	assert.Equal([]int{5, 20, 40, 55}, take(all, value, -1))
+	assert.Equal([]int{55, 40, 20, 5}, take(backward, value, -1))
+	assert.Equal([]int{40, 55}, take(ascend, value, -1))
+	assert.Equal([]int{20, 5}, take(descend, value, -1))
+}
+
+func TestRBTreeIteratorsAfterChange(t *testing.T) {
+	assert := assert.New(t)
+	rbt := tree.NewRBTree()
+	for _, v := range []int{20, 10, 40, 30, 50} {
+		rbt.Insert(v)
+	}
+
+	all, backward := rbt.All(), rbt.Backward()
+	ascend, descend := rbt.Ascend(30), rbt.Descend(30)
+
+	// the sequences are created before the tree changes, so the nodes they
+	// would have started from are either deleted or no longer the first
+	rbt.Insert(5)
+	for _, v := range []int{10, 30, 50} {
+		n, ok := rbt.Search(rbt.Root(), v)
+		assert.True(ok)
+		rbt.Delete(n)
+	}
+	rbt.Insert(55)
+
+	value := func(n *tree.RBNode) int { return n.Value }
+	assert.Equal([]int{5, 20, 40, 55}, take(all, value, -1))
+	assert.Equal([]int{55, 40, 20, 5}, take(backward, value, -1))
+	assert.Equal([]int{40, 55}, take(ascend, value, -1))
+	assert.Equal([]int{20, 5}, take(descend, value, -1))
+}
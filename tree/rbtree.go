@@ -1,6 +1,9 @@
 package tree
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
 // Color represents the color of a node.
 type Color int
@@ -492,6 +495,110 @@ func (t *RBTree) rotateRight(n *RBNode) {
 	n.parent = m
 }
 
+// All returns an iterator over the nodes of the tree in ascending order of
+// their values. The iteration starts at the minimum node and moves on to the
+// successor of each node, so no extra storage is needed, and the iteration
+// can be stopped early at no cost.
+func (t *RBTree) All() iter.Seq[*RBNode] {
+	return t.ascend(func() *RBNode { return t.first() })
+}
+
+// Backward returns an iterator over the nodes of the tree in descending order
+// of their values, by moving on to the predecessor of each node.
+func (t *RBTree) Backward() iter.Seq[*RBNode] {
+	return t.descend(func() *RBNode { return t.last() })
+}
+
+// Ascend returns an iterator over the nodes of the tree with a value greater
+// than or equal to a given value, in ascending order of their values.
+func (t *RBTree) Ascend(from int) iter.Seq[*RBNode] {
+	return t.ascend(func() *RBNode { return t.ceiling(from) })
+}
+
+// Descend returns an iterator over the nodes of the tree with a value less
+// than or equal to a given value, in descending order of their values.
+func (t *RBTree) Descend(from int) iter.Seq[*RBNode] {
+	return t.descend(func() *RBNode { return t.floor(from) })
+}
+
+// ascend returns an iterator over the nodes of the tree from the node returned
+// by start and its successors. The start node is looked up each time the
+// iterator is ranged over, so every iteration starts from the current tree.
+func (t *RBTree) ascend(start func() *RBNode) iter.Seq[*RBNode] {
+	return func(yield func(*RBNode) bool) {
+		for m := start(); m != nil; m, _ = t.Successor(m) {
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+// descend returns an iterator over the nodes of the tree from the node returned
+// by start and its predecessors. As for ascend, start is called each time the
+// iterator is ranged over.
+func (t *RBTree) descend(start func() *RBNode) iter.Seq[*RBNode] {
+	return func(yield func(*RBNode) bool) {
+		for m := start(); m != nil; m, _ = t.Predecessor(m) {
+			if !yield(m) {
+				return
+			}
+		}
+	}
+}
+
+// ceiling returns the least node with a value not less than v, or nil if there
+// is none.
+func (t *RBTree) ceiling(v int) *RBNode {
+	var m *RBNode
+	for n := t.root; n != nil; {
+		if n.Value >= v {
+			m = n
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+
+	return m
+}
+
+// floor returns the greatest node with a value not greater than v, or nil if
+// there is none.
+func (t *RBTree) floor(v int) *RBNode {
+	var m *RBNode
+	for n := t.root; n != nil; {
+		if n.Value <= v {
+			m = n
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+
+	return m
+}
+
+// first returns the minimum node of the tree, or nil if the tree is empty.
+func (t *RBTree) first() *RBNode {
+	n := t.root
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+
+	return n
+}
+
+// last returns the maximum node of the tree, or nil if the tree is empty.
+func (t *RBTree) last() *RBNode {
+	n := t.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+
+	return n
+}
+
 // Root returns the root of the tree.
 func (t *RBTree) Root() *RBNode {
 	return t.root